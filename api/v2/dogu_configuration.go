@@ -0,0 +1,124 @@
+package v2
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// configValidationTypeOneOf is the type of a descriptor's configuration validation which only allows the listed values.
+const configValidationTypeOneOf = "ONE_OF"
+
+func (d *Dogu) validateEnv(specPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := map[string]bool{}
+	for i, env := range d.Spec.Env {
+		envPath := specPath.Child("env").Index(i)
+		if names[env.Name] {
+			errs = append(errs, field.Duplicate(envPath.Child("name"), env.Name))
+		}
+		names[env.Name] = true
+
+		for _, msg := range validation.IsEnvVarName(env.Name) {
+			errs = append(errs, field.Invalid(envPath.Child("name"), env.Name, msg))
+		}
+
+		if env.ValueFrom != nil {
+			errs = append(errs, validateEnvVarSource(envPath, env)...)
+		}
+	}
+
+	return errs
+}
+
+func validateEnvVarSource(envPath *field.Path, env corev1.EnvVar) field.ErrorList {
+	var errs field.ErrorList
+	valueFromPath := envPath.Child("valueFrom")
+	if env.Value != "" {
+		errs = append(errs, field.Invalid(envPath.Child("value"), env.Value, "may not be set when valueFrom is set"))
+	}
+
+	source := env.ValueFrom
+	if source.FieldRef != nil || source.ResourceFieldRef != nil || source.FileKeyRef != nil {
+		errs = append(errs, field.Forbidden(valueFromPath, "only configMapKeyRef and secretKeyRef are supported"))
+	}
+
+	switch {
+	case source.ConfigMapKeyRef != nil && source.SecretKeyRef != nil:
+		errs = append(errs, field.Invalid(valueFromPath, "", "may not have more than one field specified at a time"))
+	case source.ConfigMapKeyRef != nil:
+		errs = append(errs, validateKeySelector(valueFromPath.Child("configMapKeyRef"), source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)...)
+	case source.SecretKeyRef != nil:
+		errs = append(errs, validateKeySelector(valueFromPath.Child("secretKeyRef"), source.SecretKeyRef.Name, source.SecretKeyRef.Key)...)
+	default:
+		errs = append(errs, field.Required(valueFromPath, "either configMapKeyRef or secretKeyRef must be specified"))
+	}
+
+	return errs
+}
+
+func validateKeySelector(path *field.Path, name, key string) field.ErrorList {
+	var errs field.ErrorList
+	if name == "" {
+		errs = append(errs, field.Required(path.Child("name"), ""))
+	}
+	if key == "" {
+		errs = append(errs, field.Required(path.Child("key"), ""))
+	}
+
+	return errs
+}
+
+func (d *Dogu) validateConfigOverrideKeys(specPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for _, key := range sortedKeys(d.Spec.ConfigOverrides) {
+		if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+			errs = append(errs, field.Invalid(specPath.Child("configOverrides"), key,
+				"must not be empty and must not start or end with a slash"))
+		}
+	}
+
+	return errs
+}
+
+// validateConfigOverrides checks the ConfigOverrides of the dogu resource against the configuration fields of the
+// given dogu descriptor. Every overridden key must be a configuration field of the descriptor. If the configuration
+// field only allows certain values (validation type "ONE_OF"), the overridden value must be one of them.
+func (d *Dogu) validateConfigOverrides(descriptor *core.Dogu) field.ErrorList {
+	var errs field.ErrorList
+	overridesPath := field.NewPath("spec", "configOverrides")
+	for _, key := range sortedKeys(d.Spec.ConfigOverrides) {
+		value := d.Spec.ConfigOverrides[key]
+		index := slices.IndexFunc(descriptor.Configuration, func(configField core.ConfigurationField) bool {
+			return configField.Name == key
+		})
+		if index < 0 {
+			notFoundErr := field.NotFound(overridesPath.Key(key), value)
+			notFoundErr.Detail = fmt.Sprintf("no configuration field %s in dogu descriptor %s", key, descriptor.Name)
+			errs = append(errs, notFoundErr)
+			continue
+		}
+
+		configValidation := descriptor.Configuration[index].Validation
+		if configValidation.Type == configValidationTypeOneOf && !slices.Contains(configValidation.Values, value) {
+			errs = append(errs, field.NotSupported(overridesPath.Key(key), value, configValidation.Values))
+		}
+	}
+
+	return errs
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
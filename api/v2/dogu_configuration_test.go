@@ -0,0 +1,164 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDogu_validateEnv(t *testing.T) {
+	t.Run("should succeed for literal values and references", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.Env = []corev1.EnvVar{
+			{Name: "LOG_LEVEL", Value: "debug"},
+			{Name: "PROXY", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-config"}, Key: "url"}}},
+			{Name: "API_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "api"}, Key: "token"}}},
+		}
+		dogu.Spec.ConfigOverrides = map[string]string{"logging/root": "DEBUG"}
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Empty(t, errs)
+	})
+
+	tests := []struct {
+		name      string
+		env       []corev1.EnvVar
+		wantField string
+		wantType  field.ErrorType
+	}{
+		{name: "invalid name", env: []corev1.EnvVar{{Name: "1=2", Value: "x"}}, wantField: "spec.env[0].name", wantType: field.ErrorTypeInvalid},
+		{name: "duplicate name", env: []corev1.EnvVar{{Name: "A", Value: "x"}, {Name: "A", Value: "y"}}, wantField: "spec.env[1].name", wantType: field.ErrorTypeDuplicate},
+		{
+			name: "value and valueFrom",
+			env: []corev1.EnvVar{{Name: "A", Value: "x", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "api"}, Key: "token"}}}},
+			wantField: "spec.env[0].value",
+			wantType:  field.ErrorTypeInvalid,
+		},
+		{name: "empty valueFrom", env: []corev1.EnvVar{{Name: "A", ValueFrom: &corev1.EnvVarSource{}}}, wantField: "spec.env[0].valueFrom", wantType: field.ErrorTypeRequired},
+		{
+			name: "unsupported field reference",
+			env: []corev1.EnvVar{{Name: "A", ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "api"}, Key: "token"},
+			}}},
+			wantField: "spec.env[0].valueFrom",
+			wantType:  field.ErrorTypeForbidden,
+		},
+		{
+			name: "config map and secret",
+			env: []corev1.EnvVar{{Name: "A", ValueFrom: &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cm"}, Key: "k"},
+				SecretKeyRef:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s"}, Key: "k"},
+			}}},
+			wantField: "spec.env[0].valueFrom",
+			wantType:  field.ErrorTypeInvalid,
+		},
+		{
+			name: "missing secret key",
+			env: []corev1.EnvVar{{Name: "A", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "api"}}}}},
+			wantField: "spec.env[0].valueFrom.secretKeyRef.key",
+			wantType:  field.ErrorTypeRequired,
+		},
+		{
+			name:      "missing config map name",
+			env:       []corev1.EnvVar{{Name: "A", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{Key: "url"}}}},
+			wantField: "spec.env[0].valueFrom.configMapKeyRef.name",
+			wantType:  field.ErrorTypeRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			dogu.Spec.Env = tt.env
+
+			// when
+			errs := dogu.Validate()
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+}
+
+func TestDogu_validateConfigOverrideKeys(t *testing.T) {
+	for _, key := range []string{"", "/logging/root", "logging/"} {
+		t.Run("should fail for key "+key, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			dogu.Spec.ConfigOverrides = map[string]string{key: "value"}
+
+			// when
+			errs := dogu.Validate()
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, "spec.configOverrides", errs[0].Field)
+			assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+		})
+	}
+}
+
+func TestDogu_validateConfigOverrides(t *testing.T) {
+	descriptor := &core.Dogu{
+		Name: "official/ldap",
+		Configuration: []core.ConfigurationField{
+			{Name: "logging/root", Validation: core.ValidationDescriptor{Type: "ONE_OF", Values: []string{"ERROR", "WARN", "INFO", "DEBUG"}}},
+			{Name: "admin_group"},
+		},
+	}
+
+	t.Run("should succeed for existing keys", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.ConfigOverrides = map[string]string{"logging/root": "DEBUG", "admin_group": "admins"}
+
+		// when
+		errs := dogu.validateConfigOverrides(descriptor)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for unknown key", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.ConfigOverrides = map[string]string{"unknown": "value"}
+
+		// when
+		errs := dogu.validateConfigOverrides(descriptor)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.configOverrides[unknown]", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeNotFound, errs[0].Type)
+		assert.Contains(t, errs[0].Detail, "no configuration field unknown in dogu descriptor official/ldap")
+	})
+	t.Run("should fail for value which is not allowed", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.ConfigOverrides = map[string]string{"logging/root": "TRACE"}
+
+		// when
+		errs := dogu.validateConfigOverrides(descriptor)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.configOverrides[logging/root]", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	})
+}
@@ -0,0 +1,232 @@
+package v2
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// maxFileMode is the highest permission mode of a mounted file, i.e. 0777.
+	maxFileMode = 0777
+	// doguOperatorVolumeClient is the name of the descriptor's volume client which marks volumes that are provided by
+	// the dogu operator itself, e.g. from a config map, instead of a persistent volume.
+	doguOperatorVolumeClient = "k8s-dogu-operator"
+)
+
+// ephemeralSourceTypes are data source types whose data only exists during the lifetime of the pod and thus cannot
+// be backed up and restored.
+var ephemeralSourceTypes = []DataSourceType{DataSourceEmptyDir, DataSourceCSI}
+
+func (d *Dogu) validateAdditionalMounts(specPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, mount := range d.Spec.AdditionalMounts {
+		errs = append(errs, mount.validate(specPath.Child("additionalMounts").Index(i))...)
+	}
+
+	return errs
+}
+
+func (dm DataMount) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if dm.Name == "" {
+		errs = append(errs, field.Required(path.Child("name"), ""))
+	}
+	if dm.Volume == "" {
+		errs = append(errs, field.Required(path.Child("volume"), ""))
+	}
+	if dm.Subfolder != "" {
+		errs = append(errs, validateRelativePath(path.Child("subfolder"), dm.Subfolder)...)
+	}
+
+	if dm.ReadOnly && dm.SourceType != DataSourcePersistentVolumeClaim {
+		errs = append(errs, forbiddenForSourceType(path.Child("readOnly"), dm.SourceType))
+	}
+	if dm.EmptyDir != nil && dm.SourceType != DataSourceEmptyDir {
+		errs = append(errs, forbiddenForSourceType(path.Child("emptyDir"), dm.SourceType))
+	}
+	if dm.EmptyDir != nil && dm.EmptyDir.SizeLimit != nil && dm.EmptyDir.SizeLimit.Sign() < 0 {
+		errs = append(errs, field.Invalid(path.Child("emptyDir", "sizeLimit"), dm.EmptyDir.SizeLimit.String(), "must not be negative"))
+	}
+
+	switch {
+	case dm.Projected == nil && dm.SourceType == DataSourceProjected:
+		errs = append(errs, field.Required(path.Child("projected"), fmt.Sprintf("must be set for source type %s", dm.SourceType)))
+	case dm.Projected != nil && dm.SourceType != DataSourceProjected:
+		errs = append(errs, forbiddenForSourceType(path.Child("projected"), dm.SourceType))
+	case dm.Projected != nil && len(dm.Projected.Sources) == 0:
+		errs = append(errs, field.Required(path.Child("projected", "sources"), "must contain at least one source"))
+	}
+
+	switch {
+	case dm.CSI == nil && dm.SourceType == DataSourceCSI:
+		errs = append(errs, field.Required(path.Child("csi"), fmt.Sprintf("must be set for source type %s", dm.SourceType)))
+	case dm.CSI != nil && dm.SourceType != DataSourceCSI:
+		errs = append(errs, forbiddenForSourceType(path.Child("csi"), dm.SourceType))
+	case dm.CSI != nil && dm.CSI.Driver == "":
+		errs = append(errs, field.Required(path.Child("csi", "driver"), ""))
+	}
+
+	errs = append(errs, dm.validateItems(path)...)
+
+	return errs
+}
+
+func (dm DataMount) validateItems(mountPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	keySelection := dm.SourceType == DataSourceConfigMap || dm.SourceType == DataSourceSecret
+	if len(dm.Items) > 0 && !keySelection {
+		errs = append(errs, forbiddenForSourceType(mountPath.Child("items"), dm.SourceType))
+	}
+	if dm.DefaultMode != nil && !keySelection {
+		errs = append(errs, forbiddenForSourceType(mountPath.Child("defaultMode"), dm.SourceType))
+	}
+	if dm.Optional != nil && !keySelection {
+		errs = append(errs, forbiddenForSourceType(mountPath.Child("optional"), dm.SourceType))
+	}
+
+	errs = append(errs, validateFileMode(mountPath.Child("defaultMode"), dm.DefaultMode)...)
+
+	var paths []string
+	for i, item := range dm.Items {
+		itemPath := mountPath.Child("items").Index(i)
+		if item.Key == "" {
+			errs = append(errs, field.Required(itemPath.Child("key"), ""))
+		}
+
+		pathErrs := validateRelativePath(itemPath.Child("path"), item.Path)
+		if len(pathErrs) == 0 && slices.Contains(paths, path.Clean(item.Path)) {
+			pathErrs = append(pathErrs, field.Duplicate(itemPath.Child("path"), item.Path))
+		}
+		errs = append(errs, pathErrs...)
+		paths = append(paths, path.Clean(item.Path))
+
+		errs = append(errs, validateFileMode(itemPath.Child("mode"), item.Mode)...)
+	}
+
+	return errs
+}
+
+// validateMountVolumes checks the AdditionalMounts of the dogu resource against the volumes of the given dogu
+// descriptor:
+//   - Every mount must target a volume of the descriptor.
+//   - Volumes which are provided by the dogu operator itself cannot be mounted into.
+//   - Volumes which need a backup cannot contain ephemeral data sources like EmptyDir or CSI, as their data could not
+//     be restored.
+//   - No two mounts may target overlapping paths. The target path is the subfolder within the volume, or each of
+//     the item paths within the subfolder if Items are given. Paths overlap if they are equal or if one of them
+//     contains the other, e.g. a mount into the whole volume and a mount into a subfolder of it.
+func (d *Dogu) validateMountVolumes(descriptor *core.Dogu) field.ErrorList {
+	var errs field.ErrorList
+	var targets []mountTarget
+	for i, mount := range d.Spec.AdditionalMounts {
+		mountPath := field.NewPath("spec", "additionalMounts").Index(i)
+		index := slices.IndexFunc(descriptor.Volumes, func(volume core.Volume) bool {
+			return volume.Name == mount.Volume
+		})
+		if index < 0 {
+			notFoundErr := field.NotFound(mountPath.Child("volume"), mount.Volume)
+			notFoundErr.Detail = fmt.Sprintf("no volume %s in dogu descriptor %s", mount.Volume, descriptor.Name)
+			errs = append(errs, notFoundErr)
+			continue
+		}
+
+		volume := descriptor.Volumes[index]
+		if _, ok := volume.GetClient(doguOperatorVolumeClient); ok {
+			errs = append(errs, field.Forbidden(mountPath.Child("volume"),
+				fmt.Sprintf("volume %s is provided by the dogu operator and cannot be mounted into", volume.Name)))
+			continue
+		}
+		if volume.NeedsBackup && slices.Contains(ephemeralSourceTypes, mount.SourceType) {
+			errs = append(errs, field.Forbidden(mountPath.Child("sourceType"),
+				fmt.Sprintf("volume %s needs a backup and cannot contain data of source type %s", volume.Name, mount.SourceType)))
+		}
+
+		// invalid paths and duplicate item paths within the same mount are reported by Validate
+		mountTargets := mount.targets(mountPath, volume.Path)
+		for _, target := range mountTargets {
+			overlapIndex := slices.IndexFunc(targets, target.overlaps)
+			if overlapIndex >= 0 {
+				errs = append(errs, field.Invalid(target.fieldPath, target.value, fmt.Sprintf(
+					"target path %s overlaps with the target path %s of %s", target.path,
+					targets[overlapIndex].path, targets[overlapIndex].mountPath)))
+			}
+		}
+		targets = append(targets, mountTargets...)
+	}
+
+	return errs
+}
+
+// mountTarget is a path in the dogu's container which a data mount writes to.
+type mountTarget struct {
+	// path is the absolute path in the container.
+	path string
+	// mountPath is the field path of the data mount.
+	mountPath *field.Path
+	// fieldPath and value are the field and its value which define the target path.
+	fieldPath *field.Path
+	value     string
+}
+
+// targets returns the paths within the volume mounted at the given path which the data mount writes to. Invalid
+// paths are skipped.
+func (dm DataMount) targets(mountPath *field.Path, volumePath string) []mountTarget {
+	if dm.Subfolder != "" && len(validateRelativePath(mountPath.Child("subfolder"), dm.Subfolder)) > 0 {
+		return nil
+	}
+
+	targetDir := path.Join(volumePath, dm.Subfolder)
+	if len(dm.Items) == 0 {
+		return []mountTarget{{path: targetDir, mountPath: mountPath, fieldPath: mountPath.Child("subfolder"), value: dm.Subfolder}}
+	}
+
+	var targets []mountTarget
+	for i, item := range dm.Items {
+		itemPath := mountPath.Child("items").Index(i).Child("path")
+		if len(validateRelativePath(itemPath, item.Path)) > 0 {
+			continue
+		}
+		targets = append(targets, mountTarget{path: path.Join(targetDir, item.Path), mountPath: mountPath, fieldPath: itemPath, value: item.Path})
+	}
+
+	return targets
+}
+
+// overlaps checks if one of the target paths is equal to or contains the other one.
+func (t mountTarget) overlaps(other mountTarget) bool {
+	return t.path == other.path || strings.HasPrefix(t.path, other.path+"/") || strings.HasPrefix(other.path, t.path+"/")
+}
+
+// validateRelativePath checks that the given path is a non-empty relative path which does not escape the directory
+// it is relative to.
+func validateRelativePath(fldPath *field.Path, relativePath string) field.ErrorList {
+	if relativePath == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	if path.IsAbs(relativePath) {
+		return field.ErrorList{field.Invalid(fldPath, relativePath, "must be a relative path")}
+	}
+	if slices.Contains(strings.Split(relativePath, "/"), "..") {
+		return field.ErrorList{field.Invalid(fldPath, relativePath, "must not contain '..'")}
+	}
+
+	return nil
+}
+
+func validateFileMode(fldPath *field.Path, mode *int32) field.ErrorList {
+	if mode != nil && (*mode < 0 || *mode > maxFileMode) {
+		return field.ErrorList{field.Invalid(fldPath, *mode,
+			fmt.Sprintf("must be a file mode between 0 and 0%o (octal)", maxFileMode))}
+	}
+
+	return nil
+}
+
+func forbiddenForSourceType(path *field.Path, sourceType DataSourceType) *field.Error {
+	return field.Forbidden(path, fmt.Sprintf("may not be set for source type %s", sourceType))
+}
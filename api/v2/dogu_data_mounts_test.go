@@ -0,0 +1,259 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDogu_validateAdditionalMounts(t *testing.T) {
+	sizeLimit := resource.MustParse("1Gi")
+	negativeSizeLimit := resource.MustParse("-1Gi")
+	projected := &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{
+		ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "my-configmap"}},
+	}}}
+	csi := &corev1.CSIVolumeSource{Driver: "secrets-store.csi.k8s.io", VolumeAttributes: map[string]string{"secretProviderClass": "vault"}}
+
+	t.Run("should succeed for all source types", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.AdditionalMounts = []DataMount{
+			{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory"},
+			{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Subfolder: "secrets"},
+			{SourceType: DataSourcePersistentVolumeClaim, Name: "nfs-claim", Volume: "data", ReadOnly: true},
+			{SourceType: DataSourceEmptyDir, Name: "cache", Volume: "tmp", EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory, SizeLimit: &sizeLimit}},
+			{SourceType: DataSourceEmptyDir, Name: "scratch", Volume: "tmp"},
+			{SourceType: DataSourceProjected, Name: "combined", Volume: "config", Projected: projected},
+			{SourceType: DataSourceCSI, Name: "vault", Volume: "secrets", CSI: csi},
+		}
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Empty(t, errs)
+	})
+
+	tests := []struct {
+		name      string
+		mount     DataMount
+		wantField string
+		wantType  field.ErrorType
+	}{
+		{name: "missing name", mount: DataMount{SourceType: DataSourceConfigMap, Volume: "importHistory"}, wantField: "spec.additionalMounts[0].name", wantType: field.ErrorTypeRequired},
+		{name: "missing volume", mount: DataMount{SourceType: DataSourceConfigMap, Name: "my-configmap"}, wantField: "spec.additionalMounts[0].volume", wantType: field.ErrorTypeRequired},
+		{name: "absolute subfolder", mount: DataMount{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Subfolder: "/etc"}, wantField: "spec.additionalMounts[0].subfolder", wantType: field.ErrorTypeInvalid},
+		{name: "subfolder escaping the volume", mount: DataMount{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Subfolder: "../../etc"}, wantField: "spec.additionalMounts[0].subfolder", wantType: field.ErrorTypeInvalid},
+		{name: "read only config map", mount: DataMount{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", ReadOnly: true}, wantField: "spec.additionalMounts[0].readOnly", wantType: field.ErrorTypeForbidden},
+		{name: "empty dir options for secret", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", EmptyDir: &corev1.EmptyDirVolumeSource{}}, wantField: "spec.additionalMounts[0].emptyDir", wantType: field.ErrorTypeForbidden},
+		{name: "negative empty dir size limit", mount: DataMount{SourceType: DataSourceEmptyDir, Name: "cache", Volume: "tmp", EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &negativeSizeLimit}}, wantField: "spec.additionalMounts[0].emptyDir.sizeLimit", wantType: field.ErrorTypeInvalid},
+		{name: "missing projected sources", mount: DataMount{SourceType: DataSourceProjected, Name: "combined", Volume: "config"}, wantField: "spec.additionalMounts[0].projected", wantType: field.ErrorTypeRequired},
+		{name: "empty projected sources", mount: DataMount{SourceType: DataSourceProjected, Name: "combined", Volume: "config", Projected: &corev1.ProjectedVolumeSource{}}, wantField: "spec.additionalMounts[0].projected.sources", wantType: field.ErrorTypeRequired},
+		{name: "projected sources for pvc", mount: DataMount{SourceType: DataSourcePersistentVolumeClaim, Name: "nfs-claim", Volume: "data", Projected: projected}, wantField: "spec.additionalMounts[0].projected", wantType: field.ErrorTypeForbidden},
+		{name: "missing csi options", mount: DataMount{SourceType: DataSourceCSI, Name: "vault", Volume: "secrets"}, wantField: "spec.additionalMounts[0].csi", wantType: field.ErrorTypeRequired},
+		{name: "missing csi driver", mount: DataMount{SourceType: DataSourceCSI, Name: "vault", Volume: "secrets", CSI: &corev1.CSIVolumeSource{}}, wantField: "spec.additionalMounts[0].csi.driver", wantType: field.ErrorTypeRequired},
+		{name: "csi options for empty dir", mount: DataMount{SourceType: DataSourceEmptyDir, Name: "cache", Volume: "tmp", CSI: csi}, wantField: "spec.additionalMounts[0].csi", wantType: field.ErrorTypeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			dogu.Spec.AdditionalMounts = []DataMount{tt.mount}
+
+			// when
+			errs := dogu.Validate()
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+}
+
+func TestDataMount_validateItems(t *testing.T) {
+	readOnlyMode := int32(0400)
+	invalidMode := int32(01000)
+	optional := true
+
+	t.Run("should succeed for selected keys with permissions", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.AdditionalMounts = []DataMount{
+			{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", DefaultMode: &readOnlyMode, Optional: &optional,
+				Items: []corev1.KeyToPath{{Key: "tls.key", Path: "certs/server.key", Mode: &readOnlyMode}, {Key: "tls.crt", Path: "certs/server.crt"}}},
+			{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "config.yaml", Path: "config.yaml"}}},
+		}
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Empty(t, errs)
+	})
+
+	tests := []struct {
+		name      string
+		mount     DataMount
+		wantField string
+		wantType  field.ErrorType
+	}{
+		{name: "items for pvc", mount: DataMount{SourceType: DataSourcePersistentVolumeClaim, Name: "nfs-claim", Volume: "data", Items: []corev1.KeyToPath{{Key: "a", Path: "a"}}}, wantField: "spec.additionalMounts[0].items", wantType: field.ErrorTypeForbidden},
+		{name: "default mode for empty dir", mount: DataMount{SourceType: DataSourceEmptyDir, Name: "cache", Volume: "tmp", DefaultMode: &readOnlyMode}, wantField: "spec.additionalMounts[0].defaultMode", wantType: field.ErrorTypeForbidden},
+		{name: "optional for csi", mount: DataMount{SourceType: DataSourceCSI, Name: "vault", Volume: "secrets", CSI: &corev1.CSIVolumeSource{Driver: "csi"}, Optional: &optional}, wantField: "spec.additionalMounts[0].optional", wantType: field.ErrorTypeForbidden},
+		{name: "invalid default mode", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", DefaultMode: &invalidMode}, wantField: "spec.additionalMounts[0].defaultMode", wantType: field.ErrorTypeInvalid},
+		{name: "invalid item mode", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "a", Path: "a", Mode: &invalidMode}}}, wantField: "spec.additionalMounts[0].items[0].mode", wantType: field.ErrorTypeInvalid},
+		{name: "missing key", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Path: "a"}}}, wantField: "spec.additionalMounts[0].items[0].key", wantType: field.ErrorTypeRequired},
+		{name: "missing path", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "a"}}}, wantField: "spec.additionalMounts[0].items[0].path", wantType: field.ErrorTypeRequired},
+		{name: "absolute path", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "a", Path: "/etc/passwd"}}}, wantField: "spec.additionalMounts[0].items[0].path", wantType: field.ErrorTypeInvalid},
+		{name: "path escaping the volume", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "a", Path: "certs/../../a"}}}, wantField: "spec.additionalMounts[0].items[0].path", wantType: field.ErrorTypeInvalid},
+		{name: "duplicate path", mount: DataMount{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "a", Path: "certs/a"}, {Key: "b", Path: "certs//a"}}}, wantField: "spec.additionalMounts[0].items[1].path", wantType: field.ErrorTypeDuplicate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			dogu.Spec.AdditionalMounts = []DataMount{tt.mount}
+
+			// when
+			errs := dogu.Validate()
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+}
+
+func TestDogu_validateMountVolumes(t *testing.T) {
+	descriptor := &core.Dogu{
+		Name: "official/usermgt",
+		Volumes: []core.Volume{
+			{Name: "importHistory", Path: "/var/lib/usermgt/importHistory", NeedsBackup: true},
+			{Name: "tmp", Path: "/tmp"},
+			{Name: "menu-json", Path: "/var/www/html/warp/menu", Clients: []core.VolumeClient{{Name: "k8s-dogu-operator"}}},
+		},
+	}
+
+	t.Run("should succeed for mounts into descriptor volumes", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.AdditionalMounts = []DataMount{
+			{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Subfolder: "config"},
+			{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Subfolder: "certs",
+				Items: []corev1.KeyToPath{{Key: "tls.key", Path: "server.key"}}},
+			{SourceType: DataSourceSecret, Name: "my-ca-secret", Volume: "importHistory", Subfolder: "certs",
+				Items: []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}}},
+			{SourceType: DataSourceSecret, Name: "other-secret", Volume: "importHistory", Subfolder: "secrets"},
+			{SourceType: DataSourceEmptyDir, Name: "cache", Volume: "tmp"},
+		}
+
+		// when
+		errs := dogu.validateMountVolumes(descriptor)
+
+		// then
+		assert.Empty(t, errs)
+	})
+
+	tests := []struct {
+		name       string
+		mounts     []DataMount
+		wantField  string
+		wantType   field.ErrorType
+		wantDetail string
+	}{
+		{
+			name:       "unknown volume",
+			mounts:     []DataMount{{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "unknown"}},
+			wantField:  "spec.additionalMounts[0].volume",
+			wantType:   field.ErrorTypeNotFound,
+			wantDetail: "no volume unknown in dogu descriptor official/usermgt",
+		},
+		{
+			name:       "volume provided by the dogu operator",
+			mounts:     []DataMount{{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "menu-json"}},
+			wantField:  "spec.additionalMounts[0].volume",
+			wantType:   field.ErrorTypeForbidden,
+			wantDetail: "volume menu-json is provided by the dogu operator and cannot be mounted into",
+		},
+		{
+			name:       "ephemeral source in volume with backup",
+			mounts:     []DataMount{{SourceType: DataSourceEmptyDir, Name: "cache", Volume: "importHistory"}},
+			wantField:  "spec.additionalMounts[0].sourceType",
+			wantType:   field.ErrorTypeForbidden,
+			wantDetail: "volume importHistory needs a backup and cannot contain data of source type EmptyDir",
+		},
+		{
+			name: "duplicate subfolder",
+			mounts: []DataMount{
+				{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Subfolder: "config"},
+				{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Subfolder: "config/"},
+			},
+			wantField:  "spec.additionalMounts[1].subfolder",
+			wantType:   field.ErrorTypeInvalid,
+			wantDetail: "target path /var/lib/usermgt/importHistory/config overlaps with the target path /var/lib/usermgt/importHistory/config of spec.additionalMounts[0]",
+		},
+		{
+			name: "subfolder within whole volume mount",
+			mounts: []DataMount{
+				{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory"},
+				{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Subfolder: "conf"},
+			},
+			wantField:  "spec.additionalMounts[1].subfolder",
+			wantType:   field.ErrorTypeInvalid,
+			wantDetail: "target path /var/lib/usermgt/importHistory/conf overlaps with the target path /var/lib/usermgt/importHistory of spec.additionalMounts[0]",
+		},
+		{
+			name: "whole volume mount containing subfolder",
+			mounts: []DataMount{
+				{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Subfolder: "conf"},
+				{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory"},
+			},
+			wantField:  "spec.additionalMounts[1].subfolder",
+			wantType:   field.ErrorTypeInvalid,
+			wantDetail: "target path /var/lib/usermgt/importHistory overlaps with the target path /var/lib/usermgt/importHistory/conf of spec.additionalMounts[0]",
+		},
+		{
+			name: "item within subfolder mount",
+			mounts: []DataMount{
+				{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Subfolder: "config"},
+				{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "b", Path: "config/b"}}},
+			},
+			wantField:  "spec.additionalMounts[1].items[0].path",
+			wantType:   field.ErrorTypeInvalid,
+			wantDetail: "target path /var/lib/usermgt/importHistory/config/b overlaps with the target path /var/lib/usermgt/importHistory/config of spec.additionalMounts[0]",
+		},
+		{
+			name: "duplicate item path",
+			mounts: []DataMount{
+				{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "importHistory", Items: []corev1.KeyToPath{{Key: "a", Path: "config/a"}}},
+				{SourceType: DataSourceSecret, Name: "my-secret", Volume: "importHistory", Subfolder: "config", Items: []corev1.KeyToPath{{Key: "b", Path: "a"}}},
+			},
+			wantField:  "spec.additionalMounts[1].items[0].path",
+			wantType:   field.ErrorTypeInvalid,
+			wantDetail: "target path /var/lib/usermgt/importHistory/config/a overlaps with the target path /var/lib/usermgt/importHistory/config/a of spec.additionalMounts[0]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			dogu.Spec.AdditionalMounts = tt.mounts
+
+			// when
+			errs := dogu.validateMountVolumes(descriptor)
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+			assert.Equal(t, tt.wantDetail, errs[0].Detail)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Default fills in default values and migrates deprecated fields, so that consumers can rely on a single field:
+//
+//   - The deprecated DataVolumeSize is moved into MinDataVolumeSize unless MinDataVolumeSize is already set.
+//     An unparseable DataVolumeSize is kept, so that it is rejected by the validation.
+//   - MinDataVolumeSize is set to the DefaultVolumeSize if no volume size is given.
+//   - The security block is normalized, see Security.Normalize.
+func (d *Dogu) Default() {
+	d.Spec.Resources.migrateDataVolumeSize()
+	d.Spec.Security.Normalize()
+}
+
+func (r *DoguResources) migrateDataVolumeSize() {
+	if r.MinDataVolumeSize.IsZero() && r.DataVolumeSize != "" {
+		size, err := resource.ParseQuantity(r.DataVolumeSize)
+		if err != nil {
+			return
+		}
+		r.MinDataVolumeSize = size
+	}
+
+	r.DataVolumeSize = ""
+	if r.MinDataVolumeSize.IsZero() {
+		r.MinDataVolumeSize = resource.MustParse(DefaultVolumeSize)
+	}
+}
+
+// Normalize brings the security block into its canonical form without changing its meaning:
+//
+//   - Capabilities are written in upper case without the "CAP_" prefix and duplicates are removed.
+//     A list containing the capability All is reduced to All.
+//   - SELinuxOptions without any label are removed.
+//   - Empty localhost profiles of SeccompProfile and AppArmorProfile are removed.
+func (s *Security) Normalize() {
+	s.Capabilities.Add = normalizeCapabilities(s.Capabilities.Add)
+	s.Capabilities.Drop = normalizeCapabilities(s.Capabilities.Drop)
+
+	if s.SELinuxOptions != nil && *s.SELinuxOptions == (SELinuxOptions{}) {
+		s.SELinuxOptions = nil
+	}
+	if s.SeccompProfile != nil && s.SeccompProfile.LocalhostProfile != nil && *s.SeccompProfile.LocalhostProfile == "" {
+		s.SeccompProfile.LocalhostProfile = nil
+	}
+	if s.AppArmorProfile != nil && s.AppArmorProfile.LocalhostProfile != nil && *s.AppArmorProfile.LocalhostProfile == "" {
+		s.AppArmorProfile.LocalhostProfile = nil
+	}
+}
+
+func normalizeCapabilities(capabilities []core.Capability) []core.Capability {
+	if capabilities == nil {
+		return nil
+	}
+
+	normalized := make([]core.Capability, 0, len(capabilities))
+	for _, capability := range capabilities {
+		value := core.Capability(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(string(capability))), "CAP_"))
+		if value == core.All {
+			return []core.Capability{core.All}
+		}
+		if !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+
+	return normalized
+}
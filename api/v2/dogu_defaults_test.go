@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestDogu_Default(t *testing.T) {
+	tests := []struct {
+		name                  string
+		resources             DoguResources
+		wantMinDataVolumeSize string
+		wantDataVolumeSize    string
+	}{
+		{name: "default size", resources: DoguResources{}, wantMinDataVolumeSize: DefaultVolumeSize},
+		{name: "migrate deprecated size", resources: DoguResources{DataVolumeSize: "5Gi"}, wantMinDataVolumeSize: "5Gi"},
+		{name: "min size takes precedence", resources: DoguResources{DataVolumeSize: "5Gi", MinDataVolumeSize: resource.MustParse("10Gi")}, wantMinDataVolumeSize: "10Gi"},
+		{name: "keep min size", resources: DoguResources{MinDataVolumeSize: resource.MustParse("1Gi")}, wantMinDataVolumeSize: "1Gi"},
+		{name: "keep unparseable deprecated size", resources: DoguResources{DataVolumeSize: "5 Gigabytes"}, wantMinDataVolumeSize: "0", wantDataVolumeSize: "5 Gigabytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := &Dogu{Spec: DoguSpec{Resources: tt.resources}}
+
+			// when
+			dogu.Default()
+
+			// then
+			assert.Equal(t, tt.wantMinDataVolumeSize, dogu.Spec.Resources.MinDataVolumeSize.String())
+			assert.Equal(t, tt.wantDataVolumeSize, dogu.Spec.Resources.DataVolumeSize)
+		})
+	}
+}
+
+func TestSecurity_Normalize(t *testing.T) {
+	t.Run("should normalize capabilities", func(t *testing.T) {
+		// given
+		security := Security{Capabilities: Capabilities{
+			Add:  []core.Capability{"chown", "CAP_CHOWN", " NET_BIND_SERVICE"},
+			Drop: []core.Capability{"CHOWN", "all"},
+		}}
+
+		// when
+		security.Normalize()
+
+		// then
+		assert.Equal(t, []core.Capability{core.Chown, core.NetBindService}, security.Capabilities.Add)
+		assert.Equal(t, []core.Capability{core.All}, security.Capabilities.Drop)
+	})
+	t.Run("should remove empty options and profiles", func(t *testing.T) {
+		// given
+		empty := ""
+		security := Security{
+			SELinuxOptions:  &SELinuxOptions{},
+			SeccompProfile:  &SeccompProfile{Type: SeccompProfileTypeRuntimeDefault, LocalhostProfile: &empty},
+			AppArmorProfile: &AppArmorProfile{Type: AppArmorProfileTypeUnconfined, LocalhostProfile: &empty},
+		}
+
+		// when
+		security.Normalize()
+
+		// then
+		assert.Nil(t, security.SELinuxOptions)
+		assert.Equal(t, &SeccompProfile{Type: SeccompProfileTypeRuntimeDefault}, security.SeccompProfile)
+		assert.Equal(t, &AppArmorProfile{Type: AppArmorProfileTypeUnconfined}, security.AppArmorProfile)
+	})
+	t.Run("should keep set options", func(t *testing.T) {
+		// given
+		security := Security{SELinuxOptions: &SELinuxOptions{Type: "spc_t"}}
+
+		// when
+		security.Normalize()
+
+		// then
+		assert.Equal(t, &SELinuxOptions{Type: "spc_t"}, security.SELinuxOptions)
+		assert.Nil(t, security.Capabilities.Add)
+	})
+}
+
+func TestDoguDefaulter_Default(t *testing.T) {
+	t.Run("should default dogu", func(t *testing.T) {
+		// given
+		dogu := &Dogu{Spec: DoguSpec{Resources: DoguResources{DataVolumeSize: "5Gi"}}}
+
+		// when
+		err := (&DoguDefaulter{}).Default(context.TODO(), dogu)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "5Gi", dogu.Spec.Resources.MinDataVolumeSize.String())
+		assert.Empty(t, dogu.Spec.Resources.DataVolumeSize)
+	})
+	t.Run("should reject other objects", func(t *testing.T) {
+		// when
+		err := (&DoguDefaulter{}).Default(context.TODO(), &DoguRestart{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected a dogu but got *v2.DoguRestart")
+	})
+}
@@ -0,0 +1,140 @@
+package v2
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// PodSecurityLevel is a level of the Kubernetes Pod Security Standards.
+// See https://kubernetes.io/docs/concepts/security/pod-security-standards/
+type PodSecurityLevel string
+
+// These constants are exported for use in other packages
+// nolint:unused
+//
+//goland:noinspection GoUnusedConst
+const (
+	// PodSecurityLevelBaseline prevents known privilege escalations.
+	PodSecurityLevelBaseline PodSecurityLevel = "baseline"
+	// PodSecurityLevelRestricted follows current pod hardening best practices.
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+)
+
+// baselineCapabilities may be added to a container on the baseline level.
+var baselineCapabilities = []corev1.Capability{
+	core.AuditWrite, core.Chown, core.DacOverride, core.Fowner, core.Fsetid, core.Kill, core.Mknod,
+	core.NetBindService, core.Setfcap, core.Setgid, core.Setpcap, core.Setuid, core.SysChroot,
+}
+
+// restrictedCapabilities may be added to a container on the restricted level.
+var restrictedCapabilities = []corev1.Capability{core.NetBindService}
+
+// baselineSELinuxTypes may be set as SELinux type on the baseline level.
+var baselineSELinuxTypes = []string{"", "container_t", "container_init_t", "container_kvm_t", "container_engine_t"}
+
+// PodSecurityReport contains the violations of a dogu against the levels of the Pod Security Standards.
+// A dogu complies with a level if there are no violations for this level.
+// +kubebuilder:object:generate=false
+type PodSecurityReport struct {
+	// Baseline contains the violations against the baseline level.
+	Baseline field.ErrorList
+	// Restricted contains the violations against the restricted level. As the restricted level includes the baseline
+	// level, it contains the baseline violations as well.
+	Restricted field.ErrorList
+}
+
+// Complies checks if the dogu complies with the given level of the Pod Security Standards.
+func (r PodSecurityReport) Complies(level PodSecurityLevel) bool {
+	return len(r.Violations(level)) == 0
+}
+
+// Violations returns the violations against the given level of the Pod Security Standards.
+func (r PodSecurityReport) Violations(level PodSecurityLevel) field.ErrorList {
+	if level == PodSecurityLevelRestricted {
+		return r.Restricted
+	}
+
+	return r.Baseline
+}
+
+// EvaluatePodSecurity checks the effective security settings of the dogu against the levels of the Pod Security
+// Standards, see GetEffectiveSecurityContexts. The descriptor is optional. Without a descriptor, the
+// core.DefaultCapabilities are assumed.
+//
+// Only the controls which can be configured by the dogu descriptor or the dogu resource are evaluated.
+// Controls like host namespaces, privileged containers or privilege escalation are set by the dogu operator.
+func EvaluatePodSecurity(dogu *Dogu, descriptor *core.Dogu) PodSecurityReport {
+	if descriptor == nil {
+		descriptor = &core.Dogu{}
+	}
+
+	podContext, containerContext := GetEffectiveSecurityContexts(descriptor, dogu)
+	securityPath := field.NewPath("spec", "security")
+
+	baseline := evaluateBaseline(securityPath, podContext, containerContext)
+	restricted := append(slices.Clone(baseline), evaluateRestricted(securityPath, podContext, containerContext)...)
+
+	return PodSecurityReport{Baseline: baseline, Restricted: restricted}
+}
+
+func evaluateBaseline(securityPath *field.Path, podContext *corev1.PodSecurityContext, containerContext *corev1.SecurityContext) field.ErrorList {
+	var errs field.ErrorList
+	for _, capability := range containerContext.Capabilities.Add {
+		if !slices.Contains(baselineCapabilities, capability) {
+			errs = append(errs, field.Forbidden(securityPath.Child("capabilities", "add"),
+				fmt.Sprintf("capability %s must not be added on the baseline level", capability)))
+		}
+	}
+
+	if podContext.SELinuxOptions != nil {
+		seLinuxPath := securityPath.Child("seLinuxOptions")
+		if !slices.Contains(baselineSELinuxTypes, podContext.SELinuxOptions.Type) {
+			errs = append(errs, field.Forbidden(seLinuxPath.Child("type"),
+				fmt.Sprintf("SELinux type %s must not be used on the baseline level", podContext.SELinuxOptions.Type)))
+		}
+		if podContext.SELinuxOptions.User != "" {
+			errs = append(errs, field.Forbidden(seLinuxPath.Child("user"), "SELinux user must not be set on the baseline level"))
+		}
+		if podContext.SELinuxOptions.Role != "" {
+			errs = append(errs, field.Forbidden(seLinuxPath.Child("role"), "SELinux role must not be set on the baseline level"))
+		}
+	}
+
+	if podContext.SeccompProfile != nil && podContext.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		errs = append(errs, field.Forbidden(securityPath.Child("seccompProfile", "type"),
+			"seccomp profile must not be Unconfined on the baseline level"))
+	}
+
+	if podContext.AppArmorProfile != nil && podContext.AppArmorProfile.Type == corev1.AppArmorProfileTypeUnconfined {
+		errs = append(errs, field.Forbidden(securityPath.Child("appArmorProfile", "type"),
+			"AppArmor profile must not be Unconfined on the baseline level"))
+	}
+
+	return errs
+}
+
+func evaluateRestricted(securityPath *field.Path, podContext *corev1.PodSecurityContext, containerContext *corev1.SecurityContext) field.ErrorList {
+	var errs field.ErrorList
+	for _, capability := range containerContext.Capabilities.Add {
+		// capabilities beyond the baseline are already reported
+		if slices.Contains(baselineCapabilities, capability) && !slices.Contains(restrictedCapabilities, capability) {
+			errs = append(errs, field.Forbidden(securityPath.Child("capabilities", "add"),
+				fmt.Sprintf("capability %s must not be added on the restricted level", capability)))
+		}
+	}
+
+	if containerContext.RunAsNonRoot == nil || !*containerContext.RunAsNonRoot {
+		errs = append(errs, field.Forbidden(securityPath.Child("runAsNonRoot"), "must be true on the restricted level"))
+	}
+
+	if podContext.SeccompProfile == nil {
+		errs = append(errs, field.Required(securityPath.Child("seccompProfile"),
+			"seccomp profile must be RuntimeDefault or Localhost on the restricted level"))
+	}
+
+	return errs
+}
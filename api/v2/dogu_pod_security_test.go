@@ -0,0 +1,89 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func errorFields(errs field.ErrorList) []string {
+	fields := make([]string, 0, len(errs))
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	return fields
+}
+
+func TestEvaluatePodSecurity(t *testing.T) {
+	trueValue := true
+
+	t.Run("should comply with baseline but not restricted with default settings", func(t *testing.T) {
+		// when
+		actual := EvaluatePodSecurity(&Dogu{}, nil)
+
+		// then
+		assert.True(t, actual.Complies(PodSecurityLevelBaseline))
+		assert.False(t, actual.Complies(PodSecurityLevelRestricted))
+		violations := actual.Violations(PodSecurityLevelRestricted)
+		assert.Contains(t, errorFields(violations), "spec.security.capabilities.add")
+		assert.Contains(t, errorFields(violations), "spec.security.runAsNonRoot")
+		assert.Contains(t, errorFields(violations), "spec.security.seccompProfile")
+		assert.Contains(t, violations.ToAggregate().Error(), "capability CHOWN must not be added on the restricted level")
+	})
+	t.Run("should comply with restricted", func(t *testing.T) {
+		// given
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{
+			Capabilities:   Capabilities{Drop: []core.Capability{core.All}, Add: []core.Capability{core.NetBindService}},
+			RunAsNonRoot:   &trueValue,
+			SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeRuntimeDefault},
+		}}}
+
+		// when
+		actual := EvaluatePodSecurity(dogu, nil)
+
+		// then
+		assert.True(t, actual.Complies(PodSecurityLevelBaseline))
+		assert.True(t, actual.Complies(PodSecurityLevelRestricted))
+	})
+	t.Run("should consider descriptor", func(t *testing.T) {
+		// given
+		descriptor := &core.Dogu{Security: core.Security{
+			Capabilities: core.Capabilities{Drop: []core.Capability{core.All}, Add: []core.Capability{core.NetBindService}},
+			RunAsNonRoot: true,
+		}}
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeRuntimeDefault}}}}
+
+		// when
+		actual := EvaluatePodSecurity(dogu, descriptor)
+
+		// then
+		assert.True(t, actual.Complies(PodSecurityLevelRestricted))
+	})
+	t.Run("should report baseline violations", func(t *testing.T) {
+		// given
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{
+			Capabilities:    Capabilities{Add: []core.Capability{core.SysAdmin}},
+			SELinuxOptions:  &SELinuxOptions{User: "system_u", Role: "system_r", Type: "spc_t"},
+			SeccompProfile:  &SeccompProfile{Type: SeccompProfileTypeUnconfined},
+			AppArmorProfile: &AppArmorProfile{Type: AppArmorProfileTypeUnconfined},
+		}}}
+
+		// when
+		actual := EvaluatePodSecurity(dogu, nil)
+
+		// then
+		assert.False(t, actual.Complies(PodSecurityLevelBaseline))
+		assert.Equal(t, []string{
+			"spec.security.capabilities.add",
+			"spec.security.seLinuxOptions.type",
+			"spec.security.seLinuxOptions.user",
+			"spec.security.seLinuxOptions.role",
+			"spec.security.seccompProfile.type",
+			"spec.security.appArmorProfile.type",
+		}, errorFields(actual.Baseline))
+		assert.Subset(t, actual.Restricted, actual.Baseline)
+		assert.Contains(t, actual.Baseline.ToAggregate().Error(), "capability SYS_ADMIN must not be added on the baseline level")
+	})
+}
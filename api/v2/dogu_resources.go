@@ -0,0 +1,178 @@
+package v2
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// supportedAccessModes are the access modes which can be used for the data volume of a dogu.
+var supportedAccessModes = []corev1.PersistentVolumeAccessMode{
+	corev1.ReadWriteOnce, corev1.ReadOnlyMany, corev1.ReadWriteMany, corev1.ReadWriteOncePod,
+}
+
+// supportedResources are the resources which can be requested and limited for a dogu.
+var supportedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage}
+
+// descriptorResourceKeys map the resources to the configuration keys of the dogu descriptor whose default values
+// define the default requests and limits of the dogu.
+var descriptorResourceKeys = map[corev1.ResourceName]struct{ request, limit string }{
+	corev1.ResourceCPU:              {request: "container_config/cpu_core_request", limit: "container_config/cpu_core_limit"},
+	corev1.ResourceMemory:           {request: "container_config/memory_request", limit: "container_config/memory_limit"},
+	corev1.ResourceEphemeralStorage: {request: "container_config/storage_request", limit: "container_config/storage_limit"},
+}
+
+// descriptorByteSizeRegex matches byte sizes in the descriptor format like "512m" with the units b, k, m and g.
+var descriptorByteSizeRegex = regexp.MustCompile(`^(\d+)([bkmg])$`)
+
+var descriptorByteSizeUnits = map[string]string{"b": "", "k": "Ki", "m": "Mi", "g": "Gi"}
+
+// Validate checks the requests and limits and the volume settings of the dogu resource. Only cpu, memory and
+// ephemeral-storage are supported. Quantities must not be negative and a request must not exceed the limit of the
+// same resource. The storage class must be a valid name and the access modes must be supported and unique.
+func (dr DoguResources) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, validateResourceList(path.Child("requests"), dr.Requests)...)
+	errs = append(errs, validateResourceList(path.Child("limits"), dr.Limits)...)
+	errs = append(errs, validateRequestsWithinLimits(path.Child("requests"), dr.Requests, dr.Limits)...)
+	errs = append(errs, dr.validateVolumes(path)...)
+
+	return errs
+}
+
+func (dr DoguResources) validateVolumes(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if dr.StorageClassName != nil && *dr.StorageClassName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(*dr.StorageClassName) {
+			errs = append(errs, field.Invalid(path.Child("storageClassName"), *dr.StorageClassName, msg))
+		}
+	}
+
+	for i, accessMode := range dr.AccessModes {
+		accessModePath := path.Child("accessModes").Index(i)
+		if !slices.Contains(supportedAccessModes, accessMode) {
+			errs = append(errs, field.NotSupported(accessModePath, accessMode, supportedAccessModes))
+		} else if slices.Contains(dr.AccessModes[:i], accessMode) {
+			errs = append(errs, field.Duplicate(accessModePath, accessMode))
+		}
+	}
+
+	if dr.EphemeralVolumeSize.Sign() < 0 {
+		errs = append(errs, field.Invalid(path.Child("ephemeralVolumeSize"), dr.EphemeralVolumeSize.String(), "must not be negative"))
+	}
+
+	return errs
+}
+
+func validateResourceList(path *field.Path, resources corev1.ResourceList) field.ErrorList {
+	var errs field.ErrorList
+	for _, name := range sortedResourceNames(resources) {
+		quantity := resources[name]
+		if !slices.Contains(supportedResources, name) {
+			errs = append(errs, field.NotSupported(path.Key(string(name)), name, supportedResources))
+			continue
+		}
+		if quantity.Sign() < 0 {
+			errs = append(errs, field.Invalid(path.Key(string(name)), quantity.String(), "must not be negative"))
+		}
+	}
+
+	return errs
+}
+
+func validateRequestsWithinLimits(requestsPath *field.Path, requests, limits corev1.ResourceList) field.ErrorList {
+	var errs field.ErrorList
+	for _, name := range sortedResourceNames(requests) {
+		request := requests[name]
+		limit, ok := limits[name]
+		if ok && request.Cmp(limit) > 0 {
+			errs = append(errs, field.Invalid(requestsPath.Key(string(name)), request.String(),
+				fmt.Sprintf("must not exceed the limit %s", limit.String())))
+		}
+	}
+
+	return errs
+}
+
+func sortedResourceNames(resources corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// GetEffectiveResourceRequirements merges the requests and limits of the dogu resource with the defaults of the
+// dogu descriptor. The descriptor defines the defaults with the default values of the configuration keys
+// "container_config/cpu_core_request", "container_config/cpu_core_limit", "container_config/memory_request",
+// "container_config/memory_limit", "container_config/storage_request" and "container_config/storage_limit".
+// Memory and storage sizes of the descriptor use the units b, k, m and g (e.g. "512m").
+//
+// The requests and limits of the dogu resource take precedence over the descriptor for each resource.
+// An error is returned if a default of the descriptor cannot be parsed or if an effective request exceeds
+// the effective limit of the same resource.
+func GetEffectiveResourceRequirements(descriptor *core.Dogu, dogu *Dogu) (corev1.ResourceRequirements, error) {
+	requirements := corev1.ResourceRequirements{Requests: corev1.ResourceList{}, Limits: corev1.ResourceList{}}
+	for _, name := range supportedResources {
+		keys := descriptorResourceKeys[name]
+		err := setDescriptorDefault(requirements.Requests, name, descriptor, keys.request)
+		if err != nil {
+			return corev1.ResourceRequirements{}, err
+		}
+		err = setDescriptorDefault(requirements.Limits, name, descriptor, keys.limit)
+		if err != nil {
+			return corev1.ResourceRequirements{}, err
+		}
+	}
+
+	for name, quantity := range dogu.Spec.Resources.Requests {
+		requirements.Requests[name] = quantity.DeepCopy()
+	}
+	for name, quantity := range dogu.Spec.Resources.Limits {
+		requirements.Limits[name] = quantity.DeepCopy()
+	}
+
+	errs := validateRequestsWithinLimits(field.NewPath("requests"), requirements.Requests, requirements.Limits)
+	if len(errs) > 0 {
+		return corev1.ResourceRequirements{}, fmt.Errorf("invalid effective resource requirements for dogu %s: %w", dogu.Name, errs.ToAggregate())
+	}
+
+	return requirements, nil
+}
+
+func setDescriptorDefault(resources corev1.ResourceList, name corev1.ResourceName, descriptor *core.Dogu, key string) error {
+	index := slices.IndexFunc(descriptor.Configuration, func(configField core.ConfigurationField) bool {
+		return configField.Name == key
+	})
+	if index < 0 || descriptor.Configuration[index].Default == "" {
+		return nil
+	}
+
+	quantity, err := parseDescriptorQuantity(name, descriptor.Configuration[index].Default)
+	if err != nil {
+		return fmt.Errorf("failed to parse default value of %s of dogu descriptor %s: %w", key, descriptor.Name, err)
+	}
+	resources[name] = quantity
+
+	return nil
+}
+
+func parseDescriptorQuantity(name corev1.ResourceName, value string) (resource.Quantity, error) {
+	if name != corev1.ResourceCPU {
+		matches := descriptorByteSizeRegex.FindStringSubmatch(strings.ToLower(value))
+		if matches != nil {
+			value = matches[1] + descriptorByteSizeUnits[matches[2]]
+		}
+	}
+
+	return resource.ParseQuantity(value)
+}
@@ -0,0 +1,227 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDoguResources_Validate(t *testing.T) {
+	path := field.NewPath("spec", "resources")
+
+	t.Run("should succeed for valid requests and limits", func(t *testing.T) {
+		// given
+		sut := DoguResources{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceEphemeralStorage: resource.MustParse("2Gi")},
+		}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for unsupported resource", func(t *testing.T) {
+		// given
+		sut := DoguResources{Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.limits[nvidia.com/gpu]", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	})
+	t.Run("should fail for negative quantity", func(t *testing.T) {
+		// given
+		sut := DoguResources{Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")}}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.requests[memory]", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	})
+	t.Run("should fail for request exceeding limit", func(t *testing.T) {
+		// given
+		sut := DoguResources{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.requests[memory]", errs[0].Field)
+		assert.Contains(t, errs[0].Detail, "must not exceed the limit 1Gi")
+	})
+	t.Run("should succeed for valid volume settings", func(t *testing.T) {
+		// given
+		storageClass := "longhorn"
+		volumeMode := corev1.PersistentVolumeFilesystem
+		sut := DoguResources{
+			StorageClassName:    &storageClass,
+			AccessModes:         []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadOnlyMany},
+			VolumeMode:          &volumeMode,
+			EphemeralVolumeSize: resource.MustParse("1Gi"),
+		}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should succeed for empty storage class", func(t *testing.T) {
+		// given
+		storageClass := ""
+		sut := DoguResources{StorageClassName: &storageClass}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for invalid storage class", func(t *testing.T) {
+		// given
+		storageClass := "Long_Horn"
+		sut := DoguResources{StorageClassName: &storageClass}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		require.NotEmpty(t, errs)
+		assert.Equal(t, "spec.resources.storageClassName", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	})
+	t.Run("should fail for unsupported and duplicate access modes", func(t *testing.T) {
+		// given
+		sut := DoguResources{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, "ReadWriteAll", corev1.ReadWriteOnce}}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		require.Len(t, errs, 2)
+		assert.Equal(t, "spec.resources.accessModes[1]", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+		assert.Equal(t, "spec.resources.accessModes[2]", errs[1].Field)
+		assert.Equal(t, field.ErrorTypeDuplicate, errs[1].Type)
+	})
+	t.Run("should fail for negative ephemeral volume size", func(t *testing.T) {
+		// given
+		sut := DoguResources{EphemeralVolumeSize: resource.MustParse("-1Gi")}
+
+		// when
+		errs := sut.Validate(path)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.ephemeralVolumeSize", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	})
+}
+
+func TestGetEffectiveResourceRequirements(t *testing.T) {
+	descriptor := &core.Dogu{
+		Name: "official/ldap",
+		Configuration: []core.ConfigurationField{
+			{Name: "container_config/cpu_core_request", Default: "0.5"},
+			{Name: "container_config/cpu_core_limit", Default: "2"},
+			{Name: "container_config/memory_request", Default: "512m"},
+			{Name: "container_config/memory_limit", Default: "1g"},
+			{Name: "container_config/storage_limit", Default: "2G"},
+			{Name: "logging/root", Default: "INFO"},
+		},
+	}
+
+	t.Run("should return empty requirements without defaults and overrides", func(t *testing.T) {
+		// when
+		actual, err := GetEffectiveResourceRequirements(&core.Dogu{}, &Dogu{})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, actual.Requests)
+		assert.Empty(t, actual.Limits)
+	})
+	t.Run("should use descriptor defaults", func(t *testing.T) {
+		// when
+		actual, err := GetEffectiveResourceRequirements(descriptor, &Dogu{})
+
+		// then
+		require.NoError(t, err)
+		assertQuantity(t, "500m", actual.Requests[corev1.ResourceCPU])
+		assertQuantity(t, "512Mi", actual.Requests[corev1.ResourceMemory])
+		assertQuantity(t, "2", actual.Limits[corev1.ResourceCPU])
+		assertQuantity(t, "1Gi", actual.Limits[corev1.ResourceMemory])
+		assertQuantity(t, "2Gi", actual.Limits[corev1.ResourceEphemeralStorage])
+		assert.NotContains(t, actual.Requests, corev1.ResourceEphemeralStorage)
+	})
+	t.Run("should override descriptor defaults", func(t *testing.T) {
+		// given
+		dogu := &Dogu{Spec: DoguSpec{Resources: DoguResources{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("768Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		}}}
+
+		// when
+		actual, err := GetEffectiveResourceRequirements(descriptor, dogu)
+
+		// then
+		require.NoError(t, err)
+		assertQuantity(t, "768Mi", actual.Requests[corev1.ResourceMemory])
+		assertQuantity(t, "2Gi", actual.Limits[corev1.ResourceMemory])
+		assertQuantity(t, "500m", actual.Requests[corev1.ResourceCPU])
+	})
+	t.Run("should fail if override exceeds descriptor limit", func(t *testing.T) {
+		// given
+		dogu := &Dogu{
+			ObjectMeta: metav1.ObjectMeta{Name: "ldap"},
+			Spec: DoguSpec{Resources: DoguResources{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			}},
+		}
+
+		// when
+		_, err := GetEffectiveResourceRequirements(descriptor, dogu)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "invalid effective resource requirements for dogu ldap")
+		assert.ErrorContains(t, err, "requests[cpu]")
+	})
+	t.Run("should fail for invalid descriptor default", func(t *testing.T) {
+		// given
+		invalidDescriptor := &core.Dogu{
+			Name:          "official/ldap",
+			Configuration: []core.ConfigurationField{{Name: "container_config/memory_limit", Default: "lots"}},
+		}
+
+		// when
+		_, err := GetEffectiveResourceRequirements(invalidDescriptor, &Dogu{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to parse default value of container_config/memory_limit of dogu descriptor official/ldap")
+	})
+}
+
+func assertQuantity(t *testing.T, expected string, actual resource.Quantity) {
+	t.Helper()
+	expectedQuantity := resource.MustParse(expected)
+	assert.Zero(t, expectedQuantity.Cmp(actual), "expected %s but got %s", expected, actual.String())
+}
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var supportedTolerationOperators = []corev1.TolerationOperator{corev1.TolerationOpEqual, corev1.TolerationOpExists}
+
+var supportedTaintEffects = []corev1.TaintEffect{corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute}
+
+var supportedUnsatisfiableConstraintActions = []corev1.UnsatisfiableConstraintAction{corev1.DoNotSchedule, corev1.ScheduleAnyway}
+
+// validateScheduling checks the scheduling controls of the dogu resource. The affinity is validated by the
+// Kubernetes API when the dogu's pods are created.
+func (d *Dogu) validateScheduling(specPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	nodeSelectorPath := specPath.Child("nodeSelector")
+	for _, key := range sortedKeys(d.Spec.NodeSelector) {
+		value := d.Spec.NodeSelector[key]
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(nodeSelectorPath.Key(key), key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(value) {
+			errs = append(errs, field.Invalid(nodeSelectorPath.Key(key), value, msg))
+		}
+	}
+
+	for i, toleration := range d.Spec.Tolerations {
+		errs = append(errs, validateToleration(specPath.Child("tolerations").Index(i), toleration)...)
+	}
+
+	for i, constraint := range d.Spec.TopologySpreadConstraints {
+		errs = append(errs, validateTopologySpreadConstraint(specPath.Child("topologySpreadConstraints").Index(i), constraint)...)
+	}
+
+	if d.Spec.PriorityClassName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(d.Spec.PriorityClassName) {
+			errs = append(errs, field.Invalid(specPath.Child("priorityClassName"), d.Spec.PriorityClassName, msg))
+		}
+	}
+
+	return errs
+}
+
+func validateToleration(path *field.Path, toleration corev1.Toleration) field.ErrorList {
+	var errs field.ErrorList
+	if toleration.Key != "" {
+		for _, msg := range validation.IsQualifiedName(toleration.Key) {
+			errs = append(errs, field.Invalid(path.Child("key"), toleration.Key, msg))
+		}
+	}
+
+	switch toleration.Operator {
+	case corev1.TolerationOpEqual, "":
+		if toleration.Key == "" {
+			errs = append(errs, field.Invalid(path.Child("operator"), toleration.Operator,
+				"operator must be Exists when key is empty"))
+		}
+	case corev1.TolerationOpExists:
+		if toleration.Value != "" {
+			errs = append(errs, field.Invalid(path.Child("value"), toleration.Value,
+				"value must be empty when operator is Exists"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(path.Child("operator"), toleration.Operator, supportedTolerationOperators))
+	}
+
+	if toleration.Effect != "" && !slices.Contains(supportedTaintEffects, toleration.Effect) {
+		errs = append(errs, field.NotSupported(path.Child("effect"), toleration.Effect, supportedTaintEffects))
+	}
+	if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+		errs = append(errs, field.Invalid(path.Child("effect"), toleration.Effect,
+			"effect must be NoExecute when tolerationSeconds is set"))
+	}
+
+	return errs
+}
+
+func validateTopologySpreadConstraint(path *field.Path, constraint corev1.TopologySpreadConstraint) field.ErrorList {
+	var errs field.ErrorList
+	if constraint.MaxSkew < 1 {
+		errs = append(errs, field.Invalid(path.Child("maxSkew"), constraint.MaxSkew, "must be greater than zero"))
+	}
+	if constraint.TopologyKey == "" {
+		errs = append(errs, field.Required(path.Child("topologyKey"), "can not be empty"))
+	}
+	if !slices.Contains(supportedUnsatisfiableConstraintActions, constraint.WhenUnsatisfiable) {
+		errs = append(errs, field.NotSupported(path.Child("whenUnsatisfiable"), constraint.WhenUnsatisfiable,
+			supportedUnsatisfiableConstraintActions))
+	}
+	if constraint.MinDomains != nil && *constraint.MinDomains < 1 {
+		errs = append(errs, field.Invalid(path.Child("minDomains"), *constraint.MinDomains, "must be greater than zero"))
+	}
+	if constraint.MinDomains != nil && constraint.WhenUnsatisfiable != corev1.DoNotSchedule {
+		errs = append(errs, field.Invalid(path.Child("minDomains"), *constraint.MinDomains,
+			fmt.Sprintf("can only be set when whenUnsatisfiable is %s", corev1.DoNotSchedule)))
+	}
+
+	return errs
+}
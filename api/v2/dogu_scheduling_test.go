@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestDogu_validateScheduling(t *testing.T) {
+	tolerationSeconds := int64(300)
+	minDomains := int32(2)
+
+	t.Run("should succeed for valid scheduling controls", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.NodeSelector = map[string]string{"kubernetes.io/os": "linux", "node-pool": "dogus"}
+		dogu.Spec.Tolerations = []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "dogus", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "node.kubernetes.io/unreachable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: &tolerationSeconds},
+			{Operator: corev1.TolerationOpExists},
+		}
+		dogu.Spec.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}},
+			}}},
+		}}
+		dogu.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.DoNotSchedule, MinDomains: &minDomains},
+		}
+		dogu.Spec.PriorityClassName = "ces-high-priority"
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Empty(t, errs)
+	})
+
+	tests := []struct {
+		name      string
+		modify    func(dogu *Dogu)
+		wantField string
+		wantType  field.ErrorType
+	}{
+		{name: "invalid node selector key", modify: func(dogu *Dogu) { dogu.Spec.NodeSelector = map[string]string{"-pool": "dogus"} }, wantField: "spec.nodeSelector[-pool]", wantType: field.ErrorTypeInvalid},
+		{name: "invalid node selector value", modify: func(dogu *Dogu) { dogu.Spec.NodeSelector = map[string]string{"pool": "my dogus"} }, wantField: "spec.nodeSelector[pool]", wantType: field.ErrorTypeInvalid},
+		{name: "equal toleration without key", modify: func(dogu *Dogu) { dogu.Spec.Tolerations = []corev1.Toleration{{Value: "dogus"}} }, wantField: "spec.tolerations[0].operator", wantType: field.ErrorTypeInvalid},
+		{name: "exists toleration with value", modify: func(dogu *Dogu) {
+			dogu.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists, Value: "dogus"}}
+		}, wantField: "spec.tolerations[0].value", wantType: field.ErrorTypeInvalid},
+		{name: "unsupported toleration operator", modify: func(dogu *Dogu) {
+			dogu.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: "In"}}
+		}, wantField: "spec.tolerations[0].operator", wantType: field.ErrorTypeNotSupported},
+		{name: "unsupported toleration effect", modify: func(dogu *Dogu) {
+			dogu.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Effect: "Evict"}}
+		}, wantField: "spec.tolerations[0].effect", wantType: field.ErrorTypeNotSupported},
+		{name: "toleration seconds without NoExecute", modify: func(dogu *Dogu) {
+			dogu.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule, TolerationSeconds: &tolerationSeconds}}
+		}, wantField: "spec.tolerations[0].effect", wantType: field.ErrorTypeInvalid},
+		{name: "zero max skew", modify: func(dogu *Dogu) {
+			dogu.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{TopologyKey: "zone", WhenUnsatisfiable: corev1.ScheduleAnyway}}
+		}, wantField: "spec.topologySpreadConstraints[0].maxSkew", wantType: field.ErrorTypeInvalid},
+		{name: "missing topology key", modify: func(dogu *Dogu) {
+			dogu.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{MaxSkew: 1, WhenUnsatisfiable: corev1.ScheduleAnyway}}
+		}, wantField: "spec.topologySpreadConstraints[0].topologyKey", wantType: field.ErrorTypeRequired},
+		{name: "unsupported unsatisfiable action", modify: func(dogu *Dogu) {
+			dogu.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{MaxSkew: 1, TopologyKey: "zone", WhenUnsatisfiable: "Ignore"}}
+		}, wantField: "spec.topologySpreadConstraints[0].whenUnsatisfiable", wantType: field.ErrorTypeNotSupported},
+		{name: "min domains with ScheduleAnyway", modify: func(dogu *Dogu) {
+			dogu.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{MaxSkew: 1, TopologyKey: "zone", WhenUnsatisfiable: corev1.ScheduleAnyway, MinDomains: &minDomains}}
+		}, wantField: "spec.topologySpreadConstraints[0].minDomains", wantType: field.ErrorTypeInvalid},
+		{name: "invalid priority class name", modify: func(dogu *Dogu) { dogu.Spec.PriorityClassName = "High_Priority" }, wantField: "spec.priorityClassName", wantType: field.ErrorTypeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			tt.modify(dogu)
+
+			// when
+			errs := dogu.Validate()
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+	t.Run("should report invalid node selector keys in order", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.NodeSelector = map[string]string{"-zone": "a", "-pool": "dogus", "-disk": "ssd"}
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		require.Len(t, errs, 3)
+		assert.Equal(t, "spec.nodeSelector[-disk]", errs[0].Field)
+		assert.Equal(t, "spec.nodeSelector[-pool]", errs[1].Field)
+		assert.Equal(t, "spec.nodeSelector[-zone]", errs[2].Field)
+	})
+}
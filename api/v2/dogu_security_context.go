@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"slices"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GetEffectiveSecurityContexts computes the security contexts of the dogu's pod and container from the security
+// policies of the given dogu descriptor and the overrides in the dogu resource's Security section.
+//
+// The effective capabilities are computed in two steps: The descriptor's capabilities modify the
+// core.DefaultCapabilities and the dogu resource's capabilities modify the result, see Capabilities.
+// Within each step, Drop is applied before Add. The container drops all capabilities and only adds the effective ones.
+//
+// RunAsNonRoot and ReadOnlyRootFileSystem of the dogu resource take precedence over the descriptor if they are set.
+// The SELinux options, the seccomp and the AppArmor profile are only defined by the dogu resource and are applied
+// to the whole pod.
+func GetEffectiveSecurityContexts(descriptor *core.Dogu, dogu *Dogu) (*corev1.PodSecurityContext, *corev1.SecurityContext) {
+	security := dogu.Spec.Security
+
+	descriptorCapabilities := core.CalcEffectiveCapabilities(core.DefaultCapabilities,
+		descriptor.Security.Capabilities.Drop, descriptor.Security.Capabilities.Add)
+	effectiveCapabilities := core.CalcEffectiveCapabilities(descriptorCapabilities,
+		security.Capabilities.Drop, security.Capabilities.Add)
+	slices.Sort(effectiveCapabilities)
+
+	added := make([]corev1.Capability, 0, len(effectiveCapabilities))
+	for _, capability := range effectiveCapabilities {
+		added = append(added, corev1.Capability(capability))
+	}
+
+	runAsNonRoot := descriptor.Security.RunAsNonRoot
+	if security.RunAsNonRoot != nil {
+		runAsNonRoot = *security.RunAsNonRoot
+	}
+	readOnlyRootFileSystem := descriptor.Security.ReadOnlyRootFileSystem
+	if security.ReadOnlyRootFileSystem != nil {
+		readOnlyRootFileSystem = *security.ReadOnlyRootFileSystem
+	}
+
+	containerSecurityContext := &corev1.SecurityContext{
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{core.All},
+			Add:  added,
+		},
+		RunAsNonRoot:           &runAsNonRoot,
+		ReadOnlyRootFilesystem: &readOnlyRootFileSystem,
+	}
+
+	podSecurityContext := &corev1.PodSecurityContext{}
+	if security.SELinuxOptions != nil {
+		podSecurityContext.SELinuxOptions = &corev1.SELinuxOptions{
+			User:  security.SELinuxOptions.User,
+			Role:  security.SELinuxOptions.Role,
+			Type:  security.SELinuxOptions.Type,
+			Level: security.SELinuxOptions.Level,
+		}
+	}
+	if security.SeccompProfile != nil {
+		podSecurityContext.SeccompProfile = &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileType(security.SeccompProfile.Type),
+			LocalhostProfile: security.SeccompProfile.LocalhostProfile,
+		}
+	}
+	if security.AppArmorProfile != nil {
+		podSecurityContext.AppArmorProfile = &corev1.AppArmorProfile{
+			Type:             corev1.AppArmorProfileType(security.AppArmorProfile.Type),
+			LocalhostProfile: security.AppArmorProfile.LocalhostProfile,
+		}
+	}
+
+	return podSecurityContext, containerSecurityContext
+}
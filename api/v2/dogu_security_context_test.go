@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetEffectiveSecurityContexts(t *testing.T) {
+	trueValue := true
+	falseValue := false
+
+	t.Run("should use default capabilities", func(t *testing.T) {
+		// when
+		podContext, containerContext := GetEffectiveSecurityContexts(&core.Dogu{}, &Dogu{})
+
+		// then
+		assert.Equal(t, &corev1.PodSecurityContext{}, podContext)
+		assert.Equal(t, []corev1.Capability{core.All}, containerContext.Capabilities.Drop)
+		assert.Equal(t, []corev1.Capability{core.Chown, core.DacOverride, core.Fowner, core.Fsetid, core.Kill,
+			core.NetBindService, core.Setgid, core.Setpcap, core.Setuid}, containerContext.Capabilities.Add)
+		assert.False(t, *containerContext.RunAsNonRoot)
+		assert.False(t, *containerContext.ReadOnlyRootFilesystem)
+	})
+	t.Run("should apply dogu resource capabilities to descriptor capabilities", func(t *testing.T) {
+		// given
+		descriptor := &core.Dogu{Security: core.Security{Capabilities: core.Capabilities{
+			Drop: []core.Capability{core.All},
+			Add:  []core.Capability{core.Fowner, core.Chown},
+		}}}
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{Capabilities: Capabilities{
+			Drop: []core.Capability{core.Chown},
+			Add:  []core.Capability{core.Syslog},
+		}}}}
+
+		// when
+		_, containerContext := GetEffectiveSecurityContexts(descriptor, dogu)
+
+		// then
+		assert.Equal(t, []corev1.Capability{core.Fowner, core.Syslog}, containerContext.Capabilities.Add)
+	})
+	t.Run("should drop all capabilities before adding", func(t *testing.T) {
+		// given
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{Capabilities: Capabilities{
+			Drop: []core.Capability{core.All},
+			Add:  []core.Capability{core.NetBindService, core.Kill},
+		}}}}
+
+		// when
+		_, containerContext := GetEffectiveSecurityContexts(&core.Dogu{}, dogu)
+
+		// then
+		assert.Equal(t, []corev1.Capability{core.Kill, core.NetBindService}, containerContext.Capabilities.Add)
+	})
+	t.Run("should add all capabilities", func(t *testing.T) {
+		// given
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{Capabilities: Capabilities{Add: []core.Capability{core.All}}}}}
+
+		// when
+		_, containerContext := GetEffectiveSecurityContexts(&core.Dogu{}, dogu)
+
+		// then
+		assert.Len(t, containerContext.Capabilities.Add, len(core.AllCapabilities))
+	})
+	t.Run("should prefer flags of dogu resource", func(t *testing.T) {
+		// given
+		descriptor := &core.Dogu{Security: core.Security{RunAsNonRoot: true, ReadOnlyRootFileSystem: false}}
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{RunAsNonRoot: &falseValue, ReadOnlyRootFileSystem: &trueValue}}}
+
+		// when
+		_, containerContext := GetEffectiveSecurityContexts(descriptor, dogu)
+
+		// then
+		assert.False(t, *containerContext.RunAsNonRoot)
+		assert.True(t, *containerContext.ReadOnlyRootFilesystem)
+	})
+	t.Run("should use flags of descriptor if dogu resource does not set them", func(t *testing.T) {
+		// given
+		descriptor := &core.Dogu{Security: core.Security{RunAsNonRoot: true, ReadOnlyRootFileSystem: true}}
+
+		// when
+		_, containerContext := GetEffectiveSecurityContexts(descriptor, &Dogu{})
+
+		// then
+		assert.True(t, *containerContext.RunAsNonRoot)
+		assert.True(t, *containerContext.ReadOnlyRootFilesystem)
+	})
+	t.Run("should set profiles on pod", func(t *testing.T) {
+		// given
+		profile := "profiles/audit.json"
+		dogu := &Dogu{Spec: DoguSpec{Security: Security{
+			SELinuxOptions:  &SELinuxOptions{Type: "container_t", Level: "s0:c1,c2"},
+			SeccompProfile:  &SeccompProfile{Type: SeccompProfileTypeLocalhost, LocalhostProfile: &profile},
+			AppArmorProfile: &AppArmorProfile{Type: AppArmorProfileTypeRuntimeDefault},
+		}}}
+
+		// when
+		podContext, _ := GetEffectiveSecurityContexts(&core.Dogu{}, dogu)
+
+		// then
+		assert.Equal(t, &corev1.PodSecurityContext{
+			SELinuxOptions:  &corev1.SELinuxOptions{Type: "container_t", Level: "s0:c1,c2"},
+			SeccompProfile:  &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: &profile},
+			AppArmorProfile: &corev1.AppArmorProfile{Type: corev1.AppArmorProfileTypeRuntimeDefault},
+		}, podContext)
+	})
+}
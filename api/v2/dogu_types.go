@@ -3,9 +3,7 @@ package v2
 import (
 	"context"
 	"embed"
-	"errors"
 	"fmt"
-	"slices"
 	"time"
 
 	cescommons "github.com/cloudogu/ces-commons-lib/dogu"
@@ -14,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -52,6 +51,23 @@ type DoguSpec struct {
 	Version string `json:"version,omitempty"`
 	// Resources of the dogu (e.g. dataVolumeSize)
 	Resources DoguResources `json:"resources,omitempty"`
+	// Replicas is the desired number of pods of the dogu. It defaults to a single pod, so that the scale subresource
+	// reports 1 instead of 0 for dogus without replicas, see Dogu.GetReplicas. Use Stopped to stop the dogu instead of
+	// scaling it down to zero.
+	// The field can be changed with the scale subresource, e.g. by "kubectl scale dogu" or a HorizontalPodAutoscaler.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MinReplicas is the lower limit of replicas when the dogu is scaled automatically.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit of replicas when the dogu is scaled automatically.
+	// It must not be lower than MinReplicas.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
 	// Security overrides security policies defined in the dogu descriptor. These fields can be used to further reduce a dogu's attack surface.
 	// +optional
 	Security Security `json:"security,omitempty"`
@@ -73,6 +89,36 @@ type DoguSpec struct {
 	// AdditionalMounts provides the possibility to mount additional data into the dogu.
 	// +optional
 	AdditionalMounts []DataMount `json:"additionalMounts,omitempty" patchStrategy:"replace"` // no unique identifier, so we can't use merge
+	// NodeSelector must match the labels of a node so that the pods of the dogu can be scheduled on it.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the pods of the dogu to be scheduled on nodes with matching taints.
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity defines scheduling constraints of the dogu's pods regarding nodes and other pods.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describe how the pods of the dogu are spread across topology domains like zones
+	// or nodes.
+	// +optional
+	// +listType=map
+	// +listMapKey=topologyKey
+	// +listMapKey=whenUnsatisfiable
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PriorityClassName is the name of the PriorityClass of the dogu's pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Env contains additional environment variables of the dogu's container. The values are either literal values
+	// or references to keys of config maps or secrets.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// ConfigOverrides overrides values of the dogu config. The keys are the names of configuration fields of the
+	// dogu descriptor (e.g. "logging/root"), see Dogu.ValidateWithDescriptor.
+	// +optional
+	ConfigOverrides map[string]string `json:"configOverrides,omitempty"`
 }
 
 // DataSourceType defines the supported source types of additional data mounts.
@@ -88,6 +134,16 @@ const (
 	DataSourceConfigMap DataSourceType = "ConfigMap"
 	// DataSourceSecret mounts a secret as a data source.
 	DataSourceSecret DataSourceType = "Secret"
+	// DataSourcePersistentVolumeClaim mounts an existing persistent volume claim, e.g. a shared NFS volume,
+	// as a data source.
+	DataSourcePersistentVolumeClaim DataSourceType = "PersistentVolumeClaim"
+	// DataSourceEmptyDir mounts an empty directory which shares the lifetime of the dogu's pod as a data source.
+	DataSourceEmptyDir DataSourceType = "EmptyDir"
+	// DataSourceProjected mounts several config maps, secrets and other sources into the same directory
+	// as a data source.
+	DataSourceProjected DataSourceType = "Projected"
+	// DataSourceCSI mounts an ephemeral volume of a CSI driver as a data source.
+	DataSourceCSI DataSourceType = "CSI"
 )
 
 // DataMount is a description of what data should be mounted to a specific Dogu volume (already defined in dogu.json).
@@ -96,15 +152,51 @@ type DataMount struct {
 	// Valid options are:
 	//   ConfigMap - data stored in a kubernetes ConfigMap.
 	//   Secret - data stored in a kubernetes Secret.
-	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	//   PersistentVolumeClaim - data stored in an existing kubernetes PersistentVolumeClaim.
+	//   EmptyDir - an empty directory which shares the lifetime of the pod, see EmptyDir.
+	//   Projected - data of several sources projected into the same directory, see Projected.
+	//   CSI - an ephemeral volume provided by a CSI driver, see CSI.
+	// +kubebuilder:validation:Enum=ConfigMap;Secret;PersistentVolumeClaim;EmptyDir;Projected;CSI
 	SourceType DataSourceType `json:"sourceType"`
-	// Name is the name of the data source.
+	// Name is the name of the data source. For the source types EmptyDir, Projected and CSI, it identifies the mount.
 	Name string `json:"name"`
 	// Volume is the name of the volume to which the data should be mounted. It is defined in the respective dogu.json.
 	Volume string `json:"volume"`
-	// Subfolder defines a subfolder in which the data should be put within the volume.
+	// Subfolder defines a subfolder in which the data should be put within the volume. It must be a relative path
+	// which does not contain "..".
 	// +optional
 	Subfolder string `json:"subfolder,omitempty"`
+	// ReadOnly mounts the persistent volume claim read-only. It may only be set for the source type PersistentVolumeClaim.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// EmptyDir contains the options of the source type EmptyDir. It may only be set for this source type.
+	// +optional
+	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+	// Projected contains the sources of the source type Projected. It is required for this source type and may
+	// only be set for it.
+	// +optional
+	Projected *corev1.ProjectedVolumeSource `json:"projected,omitempty"`
+	// CSI contains the driver and its options of the source type CSI. It is required for this source type and may
+	// only be set for it.
+	// +optional
+	CSI *corev1.CSIVolumeSource `json:"csi,omitempty"`
+	// Items selects the keys of the config map or secret which are mounted and the relative paths of the files they
+	// are mounted to. Keys which are not listed are not mounted. If Items is empty, all keys are mounted as files
+	// named after the key. It may only be set for the source types ConfigMap and Secret.
+	// +optional
+	// +listType=atomic
+	Items []corev1.KeyToPath `json:"items,omitempty"`
+	// DefaultMode contains the permission bits of the mounted files, e.g. 0400. It must be an octal value between
+	// 0000 and 0777 or a decimal value between 0 and 511. The mode of a single file can be overridden in Items.
+	// It may only be set for the source types ConfigMap and Secret.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=511
+	DefaultMode *int32 `json:"defaultMode,omitempty"`
+	// Optional allows the dogu to start even if the config map or secret or one of the keys in Items does not exist.
+	// It may only be set for the source types ConfigMap and Secret.
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
 }
 
 // IngressAnnotations are annotations of nginx-ingress rules.
@@ -125,7 +217,8 @@ type UpgradeConfig struct {
 type DoguResources struct {
 	// DataVolumeSize represents the desired size of the volume. Increasing this value leads to an automatic volume
 	// expansion. This includes a downtime for the respective dogu. The default size for volumes is "2Gi".
-	// Attempts to lower the size of an existing Dogu will be ignored.
+	// Lowering the size of an existing Dogu is rejected. As the actual size of the volume may exceed this value,
+	// e.g. because the provisioner rounds it up, it may be lower than DoguStatus.DataVolumeSize.
 	// Has the format of a resource.Quantity.
 	//
 	// Deprecated. Now acts the same as MinDataVolumeSize and will soon be replaced by it.
@@ -134,11 +227,40 @@ type DoguResources struct {
 	DataVolumeSize string `json:"dataVolumeSize,omitempty"`
 	// MinDataVolumeSize represents the minimum desired size of the volume. Increasing this value leads to an automatic volume
 	// expansion. This includes a downtime for the respective dogu. The default size for volumes is "2Gi".
-	// Attempts to lower the size of an existing Dogu will be ignored.
+	// Lowering the size of an existing Dogu is rejected. As the actual size of the volume may exceed this value,
+	// e.g. because the provisioner rounds it up, it may be lower than DoguStatus.DataVolumeSize.
 	//
 	// The value of MinDataVolumeSize takes precedent over DataVolumeSize.
 	// To consider both values when reading, call Dogu.GetMinDataVolumeSize.
 	MinDataVolumeSize resource.Quantity `json:"minDataVolumeSize,omitempty"`
+	// Requests describes the minimum amount of cpu, memory and ephemeral-storage the dogu requires.
+	// They override the defaults of the dogu descriptor, see GetEffectiveResourceRequirements.
+	// A request must not exceed the limit of the same resource.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	// Limits describes the maximum amount of cpu, memory and ephemeral-storage the dogu may use.
+	// They override the defaults of the dogu descriptor, see GetEffectiveResourceRequirements.
+	// +optional
+	Limits corev1.ResourceList `json:"limits,omitempty"`
+	// StorageClassName is the storage class of the dogu's data volume, see Dogu.GetDataPVC. If it is not set, the
+	// default storage class of the cluster is used. An empty string requests a volume without storage class.
+	// The storage class cannot be changed after the dogu resource was created.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// AccessModes are the access modes of the dogu's data volume. If they are not set, ReadWriteOnce is used.
+	// The access modes cannot be changed after the dogu resource was created.
+	// +optional
+	// +listType=atomic
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// VolumeMode is the volume mode of the dogu's data volume. If it is not set, Filesystem is used.
+	// The volume mode cannot be changed after the dogu resource was created.
+	// +optional
+	// +kubebuilder:validation:Enum=Filesystem;Block
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+	// EphemeralVolumeSize is the size limit of the dogu's ephemeral volume for data without backup, see
+	// Dogu.GetEphemeralDataVolumeName. If it is not set, the size of the ephemeral volume is not limited.
+	// +optional
+	EphemeralVolumeSize resource.Quantity `json:"ephemeralVolumeSize,omitempty"`
 }
 
 type HealthStatus string
@@ -178,6 +300,12 @@ type DoguStatus struct {
 	ExportMode bool `json:"exportMode,omitempty"`
 	// DataVolumeSize shows the current size of the mounted data volume
 	DataVolumeSize *resource.Quantity `json:"dataVolumeSize,omitempty"`
+	// Replicas is the number of pods of the dogu which are currently running.
+	Replicas int32 `json:"replicas,omitempty"`
+	// ReadyReplicas is the number of pods of the dogu which are ready.
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Selector is the label selector of the dogu's pods in string form. It is used by the scale subresource.
+	Selector string `json:"selector,omitempty"`
 	// a list of conditions TRUE|FALSE
 	// e.g. MeetsMinimumDataVolumeSize -> True if status.dataVolumeSize >= spec.minDataVolumeSize
 	// +patchMergeKey=type
@@ -211,6 +339,8 @@ const (
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:printcolumn:name="Spec-Version",type="string",JSONPath=".spec.version",description="The desired version of the dogu"
 // +kubebuilder:printcolumn:name="Installed Version",type="string",JSONPath=".status.installedVersion",description="The current version of the dogu"
 // +kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.health",description="The current health state of the dogu"
@@ -218,6 +348,8 @@ const (
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="The age of the resource"
 // +kubebuilder:printcolumn:name="Healthy",type="string",JSONPath=".status.conditions[?(@.type=='healthy')].status",description="Whether the resource is healthy in the current state"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='ready')].status",description="Whether the resource is ready in the current state"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="The number of running pods of the dogu",priority=1
+// +kubebuilder:printcolumn:name="Ready Replicas",type="integer",JSONPath=".status.readyReplicas",description="The number of ready pods of the dogu",priority=1
 // +kubebuilder:printcolumn:name="Pause Reconciliation",type="string",JSONPath=".status.conditions[?(@.type=='pauseReconciliation')].status",description="Whether the resource is ready in the current state"
 
 // Dogu is the Schema for the dogus API
@@ -318,6 +450,15 @@ func (d *Dogu) Update(ctx context.Context, client client.Client) error {
 	return nil
 }
 
+// GetReplicas returns the desired number of pods of the dogu. It defaults to 1 if Replicas is not set.
+func (d *Dogu) GetReplicas() int32 {
+	if d.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *d.Spec.Replicas
+}
+
 // GetPodLabels returns labels that select a pod being associated with this dogu.
 func (d *Dogu) GetPodLabels() CesMatchingLabels {
 	return map[string]string{
@@ -346,6 +487,19 @@ func (d *Dogu) GetPod(ctx context.Context, cli client.Client) (*corev1.Pod, erro
 	return GetPodForLabels(ctx, cli, labels)
 }
 
+// GetPodWithMode returns a pod for this dogu regardless of its version. If more than one pod is found, e.g. during a
+// rolling update, the given mode decides which pod is returned, see PodSelectionMode. An error is returned if no pod
+// is found.
+func (d *Dogu) GetPodWithMode(ctx context.Context, cli client.Client, mode PodSelectionMode) (*corev1.Pod, error) {
+	return GetPodForLabelsWithMode(ctx, cli, d.GetDoguNameLabel(), mode)
+}
+
+// GetPods returns all pods of this dogu regardless of their version which match all given selectors,
+// see GetPodsForLabels.
+func (d *Dogu) GetPods(ctx context.Context, cli client.Client, selectors ...PodSelector) ([]corev1.Pod, error) {
+	return GetPodsForLabels(ctx, cli, d.GetDoguNameLabel(), selectors...)
+}
+
 // GetDataPVC returns the data pvc for this dogu.
 func (d *Dogu) GetDataPVC(ctx context.Context, cli client.Client) (*corev1.PersistentVolumeClaim, error) {
 	pvc := &corev1.PersistentVolumeClaim{}
@@ -398,33 +552,11 @@ func (d *Dogu) GetPrivateKeySecret(ctx context.Context, cli client.Client) (*cor
 }
 
 // ValidateSecurity checks the dogu's Security section for configuration errors.
+// See Security.Validate for field-path-aware errors.
 func (d *Dogu) ValidateSecurity() error {
-	var errs []error
-	for _, value := range d.Spec.Security.Capabilities.Add {
-		if value == core.All {
-			continue
-		}
-
-		if !slices.Contains(core.AllCapabilities, value) {
-			err := fmt.Errorf("%s is not a valid capability to be added", value)
-			errs = append(errs, err)
-		}
-	}
-
-	for _, value := range d.Spec.Security.Capabilities.Drop {
-		if value == core.All {
-			continue
-		}
-
-		if !slices.Contains(core.AllCapabilities, value) {
-			err := fmt.Errorf("%s is not a valid capability to be dropped", value)
-			errs = append(errs, err)
-		}
-	}
-
-	err := errors.Join(errs...)
-	if err != nil {
-		return fmt.Errorf("dogu resource %s:%s contains at least one invalid security field: %w", d.Spec.Name, d.Spec.Version, err)
+	errs := d.Spec.Security.Validate(field.NewPath("spec", "security"))
+	if len(errs) > 0 {
+		return fmt.Errorf("dogu resource %s:%s contains at least one invalid security field: %w", d.Spec.Name, d.Spec.Version, errs.ToAggregate())
 	}
 
 	return nil
@@ -1,6 +1,17 @@
 package v2
 
-import "github.com/cloudogu/cesapp-lib/core"
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// seLinuxLevelRegex matches MLS/MCS levels like "s0", "s0-s1" or "s0:c0.c1023,c1500".
+var seLinuxLevelRegex = regexp.MustCompile(`^s\d+(-s\d+)?(:c\d+(\.c\d+)?(,c\d+(\.c\d+)?)*)?$`)
 
 // Capabilities represent POSIX capabilities that can be added to or removed from a dogu.
 //
@@ -15,7 +26,7 @@ import "github.com/cloudogu/cesapp-lib/core"
 //	   "Add": "Syslog"
 //	}
 //
-// This example will always result in the following capability list: NetBindService
+// This example will always result in the following capability list: NetBindService, Kill
 //
 //	"Capabilities": {
 //	   "Drop": ["All"],
@@ -154,3 +165,90 @@ type Security struct {
 	// +optional
 	AppArmorProfile *AppArmorProfile `json:"appArmorProfile,omitempty"`
 }
+
+// Validate checks the security section for configuration errors. The errors refer to fields below the given path.
+func (s Security) Validate(securityPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, s.Capabilities.validate(securityPath.Child("capabilities"))...)
+	if s.SELinuxOptions != nil {
+		errs = append(errs, s.SELinuxOptions.validate(securityPath.Child("seLinuxOptions"))...)
+	}
+	if s.SeccompProfile != nil {
+		errs = append(errs, s.SeccompProfile.validate(securityPath.Child("seccompProfile"))...)
+	}
+	if s.AppArmorProfile != nil {
+		errs = append(errs, s.AppArmorProfile.validate(securityPath.Child("appArmorProfile"))...)
+	}
+
+	return errs
+}
+
+func (c Capabilities) validate(capabilitiesPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, value := range c.Add {
+		if value != core.All && !slices.Contains(core.AllCapabilities, value) {
+			errs = append(errs, field.Invalid(capabilitiesPath.Child("add").Index(i), value, "not a valid capability to be added"))
+		}
+	}
+	for i, value := range c.Drop {
+		if value != core.All && !slices.Contains(core.AllCapabilities, value) {
+			errs = append(errs, field.Invalid(capabilitiesPath.Child("drop").Index(i), value, "not a valid capability to be dropped"))
+		}
+	}
+
+	return errs
+}
+
+func (o SELinuxOptions) validate(seLinuxPath *field.Path) field.ErrorList {
+	if o.Level != "" && !seLinuxLevelRegex.MatchString(o.Level) {
+		return field.ErrorList{field.Invalid(seLinuxPath.Child("level"), o.Level,
+			`must be a SELinux level like "s0", "s0-s1" or "s0:c0.c1023,c1500"`)}
+	}
+
+	return nil
+}
+
+func (p SeccompProfile) validate(seccompPath *field.Path) field.ErrorList {
+	validTypes := []SeccompProfileType{SeccompProfileTypeUnconfined, SeccompProfileTypeRuntimeDefault, SeccompProfileTypeLocalhost}
+	if !slices.Contains(validTypes, p.Type) {
+		return field.ErrorList{field.NotSupported(seccompPath.Child("type"), p.Type, validTypes)}
+	}
+
+	errs := validateLocalhostProfile(seccompPath, p.Type == SeccompProfileTypeLocalhost, p.LocalhostProfile)
+	if len(errs) == 0 && p.LocalhostProfile != nil && !isDescendingRelativePath(*p.LocalhostProfile) {
+		errs = append(errs, field.Invalid(seccompPath.Child("localhostProfile"), *p.LocalhostProfile,
+			"must be a relative path without '..' segments"))
+	}
+
+	return errs
+}
+
+func (p AppArmorProfile) validate(appArmorPath *field.Path) field.ErrorList {
+	validTypes := []AppArmorProfileType{AppArmorProfileTypeUnconfined, AppArmorProfileTypeRuntimeDefault, AppArmorProfileTypeLocalhost}
+	if !slices.Contains(validTypes, p.Type) {
+		return field.ErrorList{field.NotSupported(appArmorPath.Child("type"), p.Type, validTypes)}
+	}
+
+	return validateLocalhostProfile(appArmorPath, p.Type == AppArmorProfileTypeLocalhost, p.LocalhostProfile)
+}
+
+// validateLocalhostProfile checks that a localhost profile is set if and only if the profile type is Localhost.
+func validateLocalhostProfile(profilePath *field.Path, isLocalhost bool, localhostProfile *string) field.ErrorList {
+	localhostProfilePath := profilePath.Child("localhostProfile")
+	if isLocalhost && (localhostProfile == nil || strings.TrimSpace(*localhostProfile) == "") {
+		return field.ErrorList{field.Required(localhostProfilePath, "must be set if type is Localhost")}
+	}
+	if !isLocalhost && localhostProfile != nil {
+		return field.ErrorList{field.Forbidden(localhostProfilePath, "must only be set if type is Localhost")}
+	}
+
+	return nil
+}
+
+func isDescendingRelativePath(profile string) bool {
+	if path.IsAbs(profile) {
+		return false
+	}
+
+	return !slices.Contains(strings.Split(profile, "/"), "..")
+}
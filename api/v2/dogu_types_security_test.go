@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestSecurity_Validate(t *testing.T) {
+	profile := func(value string) *string { return &value }
+
+	tests := []struct {
+		name      string
+		security  Security
+		wantField string
+		wantType  field.ErrorType
+	}{
+		{name: "invalid dropped capability", security: Security{Capabilities: Capabilities{Drop: []core.Capability{core.Chown, "err"}}}, wantField: "spec.security.capabilities.drop[1]", wantType: field.ErrorTypeInvalid},
+		{name: "invalid SELinux level", security: Security{SELinuxOptions: &SELinuxOptions{Level: "s0:c1,"}}, wantField: "spec.security.seLinuxOptions.level", wantType: field.ErrorTypeInvalid},
+		{name: "invalid seccomp type", security: Security{SeccompProfile: &SeccompProfile{Type: "Default"}}, wantField: "spec.security.seccompProfile.type", wantType: field.ErrorTypeNotSupported},
+		{name: "missing seccomp localhost profile", security: Security{SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeLocalhost}}, wantField: "spec.security.seccompProfile.localhostProfile", wantType: field.ErrorTypeRequired},
+		{name: "seccomp localhost profile for other type", security: Security{SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeRuntimeDefault, LocalhostProfile: profile("profile.json")}}, wantField: "spec.security.seccompProfile.localhostProfile", wantType: field.ErrorTypeForbidden},
+		{name: "absolute seccomp localhost profile", security: Security{SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeLocalhost, LocalhostProfile: profile("/etc/profile.json")}}, wantField: "spec.security.seccompProfile.localhostProfile", wantType: field.ErrorTypeInvalid},
+		{name: "ascending seccomp localhost profile", security: Security{SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeLocalhost, LocalhostProfile: profile("profiles/../../profile.json")}}, wantField: "spec.security.seccompProfile.localhostProfile", wantType: field.ErrorTypeInvalid},
+		{name: "invalid AppArmor type", security: Security{AppArmorProfile: &AppArmorProfile{}}, wantField: "spec.security.appArmorProfile.type", wantType: field.ErrorTypeNotSupported},
+		{name: "blank AppArmor localhost profile", security: Security{AppArmorProfile: &AppArmorProfile{Type: AppArmorProfileTypeLocalhost, LocalhostProfile: profile(" ")}}, wantField: "spec.security.appArmorProfile.localhostProfile", wantType: field.ErrorTypeRequired},
+		{name: "AppArmor localhost profile for other type", security: Security{AppArmorProfile: &AppArmorProfile{Type: AppArmorProfileTypeUnconfined, LocalhostProfile: profile("k8s-apparmor")}}, wantField: "spec.security.appArmorProfile.localhostProfile", wantType: field.ErrorTypeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			errs := tt.security.Validate(field.NewPath("spec", "security"))
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+
+	t.Run("should succeed for valid security", func(t *testing.T) {
+		// given
+		security := Security{
+			Capabilities:    Capabilities{Add: []core.Capability{core.NetBindService}, Drop: []core.Capability{core.All}},
+			SELinuxOptions:  &SELinuxOptions{User: "system_u", Role: "system_r", Type: "container_t", Level: "s0-s0:c0.c1023,c1500"},
+			SeccompProfile:  &SeccompProfile{Type: SeccompProfileTypeLocalhost, LocalhostProfile: profile("profiles/audit.json")},
+			AppArmorProfile: &AppArmorProfile{Type: AppArmorProfileTypeLocalhost, LocalhostProfile: profile("k8s-apparmor")},
+		}
+
+		// when
+		errs := security.Validate(field.NewPath("spec", "security"))
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should return all errors", func(t *testing.T) {
+		// given
+		security := Security{
+			Capabilities:   Capabilities{Add: []core.Capability{"err"}},
+			SELinuxOptions: &SELinuxOptions{Level: "c0"},
+			SeccompProfile: &SeccompProfile{Type: SeccompProfileTypeLocalhost},
+		}
+
+		// when
+		errs := security.Validate(field.NewPath("spec", "security"))
+
+		// then
+		assert.Len(t, errs, 3)
+	})
+}
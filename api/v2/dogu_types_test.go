@@ -177,6 +177,59 @@ func TestDogu_GetPod(t *testing.T) {
 	assert.Equal(t, exptectedPod, actual)
 }
 
+func TestDogu_GetReplicas(t *testing.T) {
+	t.Run("should default to 1", func(t *testing.T) {
+		assert.Equal(t, int32(1), (&Dogu{}).GetReplicas())
+	})
+	t.Run("should return replicas", func(t *testing.T) {
+		// given
+		replicas := int32(3)
+		dogu := &Dogu{Spec: DoguSpec{Replicas: &replicas}}
+
+		// when
+		actual := dogu.GetReplicas()
+
+		// then
+		assert.Equal(t, int32(3), actual)
+	})
+}
+
+func TestDogu_GetPods(t *testing.T) {
+	// given
+	currentPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "dogu-current", Labels: testDogu.GetPodLabels()}}
+	oldPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "dogu-old", Labels: CesMatchingLabels{DoguLabelName: "dogu", DoguLabelVersion: "0.0.1"}}}
+	otherPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "cas", Labels: CesMatchingLabels{DoguLabelName: "cas"}}}
+	cli := fake.NewClientBuilder().WithScheme(getDoguTypesTestScheme()).WithObjects(currentPod, oldPod, otherPod).Build()
+
+	// when
+	actual, err := testDogu.GetPods(testCtx, cli)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+	assert.ElementsMatch(t, []string{"dogu-current", "dogu-old"}, []string{actual[0].Name, actual[1].Name})
+}
+
+func TestDogu_GetPodWithMode(t *testing.T) {
+	// given
+	readyPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "dogu-ready", Labels: CesMatchingLabels{DoguLabelName: testDogu.Name, DoguLabelVersion: "0.0.1"}},
+		Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+	}
+	startingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "dogu-starting", Labels: testDogu.GetPodLabels()},
+		Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}}},
+	}
+	cli := fake.NewClientBuilder().WithScheme(getDoguTypesTestScheme()).WithObjects(readyPod, startingPod).Build()
+
+	// when
+	actual, err := testDogu.GetPodWithMode(testCtx, cli, PodSelectionPreferReady)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "dogu-ready", actual.Name)
+}
+
 func TestDevelopmentDoguMap_DeleteFromCluster(t *testing.T) {
 	t.Run("should delete a DevelopmentDogu cm", func(t *testing.T) {
 		inputCm := &corev1.ConfigMap{
@@ -334,7 +387,7 @@ func TestDogu_ValidateSecurity_message(t *testing.T) {
 
 		// then
 		require.Error(t, actual)
-		assert.ErrorContains(t, actual, "dogu resource official/dogu:1.2.3 contains at least one invalid security field: err is not a valid capability to be dropped")
+		assert.ErrorContains(t, actual, "dogu resource official/dogu:1.2.3 contains at least one invalid security field: spec.security.capabilities.drop[0]: Invalid value: \"err\": not a valid capability to be dropped")
 	})
 	t.Run("should match for add errors", func(t *testing.T) {
 		// given
@@ -345,7 +398,7 @@ func TestDogu_ValidateSecurity_message(t *testing.T) {
 
 		// then
 		require.Error(t, actual)
-		assert.ErrorContains(t, actual, "dogu resource official/dogu:1.2.3 contains at least one invalid security field: err is not a valid capability to be added")
+		assert.ErrorContains(t, actual, "dogu resource official/dogu:1.2.3 contains at least one invalid security field: spec.security.capabilities.add[0]: Invalid value: \"err\": not a valid capability to be added")
 	})
 }
 
@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	cescommons "github.com/cloudogu/ces-commons-lib/dogu"
+	"github.com/cloudogu/cesapp-lib/core"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/mutate-k8s-cloudogu-com-v2-dogu,mutating=true,failurePolicy=fail,sideEffects=None,groups=k8s.cloudogu.com,resources=dogus,verbs=create;update,versions=v2,name=mdogu.k8s.cloudogu.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-k8s-cloudogu-com-v2-dogu,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8s.cloudogu.com,resources=dogus,verbs=create;update,versions=v2,name=vdogu.k8s.cloudogu.com,admissionReviewVersions=v1
+
+// interface constraints
+var _ admission.CustomValidator = &DoguValidator{}
+var _ admission.CustomDefaulter = &DoguDefaulter{}
+
+// DoguValidator validates dogu resources when they get created or updated.
+// +kubebuilder:object:generate=false
+type DoguValidator struct{}
+
+// DoguDefaulter fills in default values and migrates deprecated fields of dogu resources when they get created or
+// updated.
+// +kubebuilder:object:generate=false
+type DoguDefaulter struct{}
+
+// SetupDoguWebhookWithManager registers the defaulting and the validating webhook for dogu resources with the given
+// manager.
+func SetupDoguWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&Dogu{}).
+		WithDefaulter(&DoguDefaulter{}).
+		WithValidator(&DoguValidator{}).
+		Complete()
+}
+
+// Default fills in default values of the given dogu resource, see Dogu.Default.
+func (df *DoguDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	dogu, err := toDogu(obj)
+	if err != nil {
+		return err
+	}
+
+	dogu.Default()
+	return nil
+}
+
+// ValidateCreate validates a new dogu resource.
+func (v *DoguValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	dogu, err := toDogu(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, toInvalidError(dogu, dogu.Validate())
+}
+
+// ValidateUpdate validates the changes of a dogu resource.
+func (v *DoguValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldDogu, err := toDogu(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	newDogu, err := toDogu(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, toInvalidError(newDogu, newDogu.ValidateUpdate(oldDogu))
+}
+
+// ValidateDelete allows the deletion of every dogu resource.
+func (v *DoguValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func toDogu(obj runtime.Object) (*Dogu, error) {
+	dogu, ok := obj.(*Dogu)
+	if !ok {
+		return nil, fmt.Errorf("expected a dogu but got %T", obj)
+	}
+
+	return dogu, nil
+}
+
+func toInvalidError(dogu *Dogu, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("Dogu").GroupKind(), dogu.Name, errs)
+}
+
+// Validate checks the dogu resource for configuration errors.
+func (d *Dogu) Validate() field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	_, err := cescommons.QualifiedNameFromString(d.Spec.Name)
+	if err != nil {
+		errs = append(errs, field.Invalid(specPath.Child("name"), d.Spec.Name, err.Error()))
+	}
+
+	_, err = core.ParseVersion(d.Spec.Version)
+	if err != nil {
+		errs = append(errs, field.Invalid(specPath.Child("version"), d.Spec.Version, err.Error()))
+	}
+
+	errs = append(errs, d.validateDataVolumeSize(specPath.Child("resources"))...)
+	errs = append(errs, d.Spec.Resources.Validate(specPath.Child("resources"))...)
+
+	errs = append(errs, d.validateReplicas(specPath)...)
+
+	errs = append(errs, d.validateScheduling(specPath)...)
+
+	errs = append(errs, d.validateEnv(specPath)...)
+	errs = append(errs, d.validateConfigOverrideKeys(specPath)...)
+
+	errs = append(errs, d.validateAdditionalMounts(specPath)...)
+
+	errs = append(errs, d.Spec.Security.Validate(specPath.Child("security"))...)
+
+	return errs
+}
+
+func (d *Dogu) validateDataVolumeSize(resourcesPath *field.Path) field.ErrorList {
+	if d.Spec.Resources.MinDataVolumeSize.Sign() < 0 {
+		return field.ErrorList{field.Invalid(resourcesPath.Child("minDataVolumeSize"),
+			d.Spec.Resources.MinDataVolumeSize.String(), "must not be negative")}
+	}
+
+	_, err := d.GetMinDataVolumeSize()
+	if err != nil {
+		return field.ErrorList{field.Invalid(resourcesPath.Child("dataVolumeSize"), d.Spec.Resources.DataVolumeSize, err.Error())}
+	}
+
+	return nil
+}
+
+// validateDataVolumeShrink rejects lowering the minimum data volume size compared to the old dogu resource. Any
+// increase is accepted, even if the data volume is still larger, e.g. because the provisioner rounded it up or the
+// size was migrated from the deprecated dataVolumeSize. The data volume itself is never shrunk in that case.
+func (d *Dogu) validateDataVolumeShrink(old *Dogu, resourcesPath *field.Path) field.ErrorList {
+	minDataVolumeSize, err := d.GetMinDataVolumeSize()
+	if err != nil {
+		// reported by Validate
+		return nil
+	}
+	oldMinDataVolumeSize, err := old.GetMinDataVolumeSize()
+	if err != nil || minDataVolumeSize.Cmp(oldMinDataVolumeSize) >= 0 {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(resourcesPath.Child("minDataVolumeSize"), fmt.Sprintf(
+		"data volume cannot be shrunk from %s to %s", oldMinDataVolumeSize.String(), minDataVolumeSize.String()))}
+}
+
+// validateReplicas only checks the bounds of the autoscaler. Replicas is not checked against them, because it is
+// also changed with the scale subresource which bypasses this validation.
+func (d *Dogu) validateReplicas(specPath *field.Path) field.ErrorList {
+	minReplicas, maxReplicas := d.Spec.MinReplicas, d.Spec.MaxReplicas
+	if minReplicas != nil && maxReplicas != nil && *minReplicas > *maxReplicas {
+		return field.ErrorList{field.Invalid(specPath.Child("maxReplicas"), *maxReplicas,
+			fmt.Sprintf("must not be lower than minReplicas %d", *minReplicas))}
+	}
+
+	return nil
+}
+
+// ValidateUpdate checks the dogu resource for configuration errors and illegal changes compared to the given old
+// dogu resource. The dogu name and the storage class, access modes and volume mode of the data volume cannot be
+// changed and the data volume cannot be shrunk. Only if UpgradeConfig.AllowNamespaceSwitch is set, the namespace of
+// the dogu may be changed.
+//
+// Configuration errors which the old dogu resource already had are ignored, so that existing dogus which violate
+// checks added in later versions can still be updated as long as the invalid fields are left unchanged.
+func (d *Dogu) ValidateUpdate(old *Dogu) field.ErrorList {
+	errs := withoutExistingErrors(d.Validate(), old.Validate())
+	resourcesPath := field.NewPath("spec", "resources")
+	errs = append(errs, d.validateDataVolumeShrink(old, resourcesPath)...)
+	errs = append(errs, apivalidation.ValidateImmutableField(d.Spec.Resources.StorageClassName,
+		old.Spec.Resources.StorageClassName, resourcesPath.Child("storageClassName"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(d.Spec.Resources.AccessModes,
+		old.Spec.Resources.AccessModes, resourcesPath.Child("accessModes"))...)
+	errs = append(errs, apivalidation.ValidateImmutableField(d.Spec.Resources.VolumeMode,
+		old.Spec.Resources.VolumeMode, resourcesPath.Child("volumeMode"))...)
+
+	namePath := field.NewPath("spec", "name")
+	if d.Spec.Name == old.Spec.Name {
+		return errs
+	}
+
+	if !d.Spec.UpgradeConfig.AllowNamespaceSwitch {
+		return append(errs, field.Invalid(namePath, d.Spec.Name, fmt.Sprintf(
+			"dogu name is immutable, changing the namespace requires spec.upgradeConfig.allowNamespaceSwitch (was %q)", old.Spec.Name)))
+	}
+
+	oldName, oldErr := cescommons.QualifiedNameFromString(old.Spec.Name)
+	newName, newErr := cescommons.QualifiedNameFromString(d.Spec.Name)
+	if oldErr == nil && newErr == nil && oldName.SimpleName != newName.SimpleName {
+		errs = append(errs, field.Invalid(namePath, d.Spec.Name, fmt.Sprintf(
+			"only the namespace of the dogu may be changed but the simple name changed (was %q)", old.Spec.Name)))
+	}
+
+	return errs
+}
+
+// withoutExistingErrors removes the errors from errs which are also contained in existingErrs. Errors are compared
+// by their field, type, value and detail, so that changing an invalid field to another invalid value is reported.
+func withoutExistingErrors(errs, existingErrs field.ErrorList) field.ErrorList {
+	existing := make(map[string]bool, len(existingErrs))
+	for _, err := range existingErrs {
+		existing[err.Error()] = true
+	}
+
+	var result field.ErrorList
+	for _, err := range errs {
+		if !existing[err.Error()] {
+			result = append(result, err)
+		}
+	}
+
+	return result
+}
+
+// ValidateWithDescriptor checks the dogu resource against the given dogu descriptor. These checks are not part of
+// Validate, because the admission webhook has no access to the dogu descriptor. Callers which fetched the descriptor,
+// e.g. the dogu operator before installing or upgrading the dogu, should run them in addition to Validate.
+// Without a descriptor, there is nothing to check against.
+//
+// The keys of ConfigOverrides must be configuration fields of the descriptor and their values must be allowed by
+// the descriptor's validation. The AdditionalMounts must target mountable volumes of the descriptor without
+// overlapping each other.
+func (d *Dogu) ValidateWithDescriptor(descriptor *core.Dogu) field.ErrorList {
+	if descriptor == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	errs = append(errs, d.validateConfigOverrides(descriptor)...)
+	errs = append(errs, d.validateMountVolumes(descriptor)...)
+
+	return errs
+}
@@ -0,0 +1,436 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidDogu() *Dogu {
+	return &Dogu{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "ecosystem"},
+		Spec: DoguSpec{
+			Name:    "official/ldap",
+			Version: "2.4.48-4",
+			Resources: DoguResources{
+				MinDataVolumeSize: resource.MustParse("2Gi"),
+			},
+		},
+	}
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+func TestDogu_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(dogu *Dogu)
+		wantField string
+		wantType  field.ErrorType
+	}{
+		{name: "missing namespace", modify: func(dogu *Dogu) { dogu.Spec.Name = "ldap" }, wantField: "spec.name", wantType: field.ErrorTypeInvalid},
+		{name: "empty simple name", modify: func(dogu *Dogu) { dogu.Spec.Name = "official/" }, wantField: "spec.name", wantType: field.ErrorTypeInvalid},
+		{name: "invalid version", modify: func(dogu *Dogu) { dogu.Spec.Version = "a.b.c" }, wantField: "spec.version", wantType: field.ErrorTypeInvalid},
+		{name: "negative volume size", modify: func(dogu *Dogu) { dogu.Spec.Resources.MinDataVolumeSize = resource.MustParse("-1Gi") }, wantField: "spec.resources.minDataVolumeSize", wantType: field.ErrorTypeInvalid},
+		{
+			name: "invalid deprecated volume size",
+			modify: func(dogu *Dogu) {
+				dogu.Spec.Resources.MinDataVolumeSize = resource.Quantity{}
+				dogu.Spec.Resources.DataVolumeSize = "2 Gigabytes"
+			},
+			wantField: "spec.resources.dataVolumeSize",
+			wantType:  field.ErrorTypeInvalid,
+		},
+		{name: "invalid capability", modify: func(dogu *Dogu) { dogu.Spec.Security.Capabilities.Add = []core.Capability{"NO_CAP"} }, wantField: "spec.security.capabilities.add[0]", wantType: field.ErrorTypeInvalid},
+		{
+			name: "request exceeding limit",
+			modify: func(dogu *Dogu) {
+				dogu.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+				dogu.Spec.Resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+			},
+			wantField: "spec.resources.requests[cpu]",
+			wantType:  field.ErrorTypeInvalid,
+		},
+		{name: "min replicas greater than max replicas", modify: func(dogu *Dogu) { dogu.Spec.MinReplicas, dogu.Spec.MaxReplicas = int32Ptr(3), int32Ptr(2) }, wantField: "spec.maxReplicas", wantType: field.ErrorTypeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			dogu := newValidDogu()
+			tt.modify(dogu)
+
+			// when
+			errs := dogu.Validate()
+
+			// then
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.wantField, errs[0].Field)
+			assert.Equal(t, tt.wantType, errs[0].Type)
+		})
+	}
+
+	t.Run("should succeed for valid dogu", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		size := resource.MustParse("2Gi")
+		dogu.Status.DataVolumeSize = &size
+		dogu.Spec.Replicas = int32Ptr(2)
+		dogu.Spec.MinReplicas = int32Ptr(1)
+		dogu.Spec.MaxReplicas = int32Ptr(3)
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should succeed for replicas outside of the autoscaler bounds", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.Replicas = int32Ptr(4)
+		dogu.Spec.MinReplicas = int32Ptr(1)
+		dogu.Spec.MaxReplicas = int32Ptr(3)
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should return all errors", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.Name = "ldap"
+		dogu.Spec.Version = ""
+
+		// when
+		errs := dogu.Validate()
+
+		// then
+		assert.Len(t, errs, 2)
+	})
+}
+
+func TestDogu_ValidateUpdate(t *testing.T) {
+	t.Run("should succeed for unchanged name", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		updated := newValidDogu()
+		updated.Spec.Version = "2.4.48-5"
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should ignore configuration errors of the old dogu resource", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		old.Spec.MinReplicas = int32Ptr(3)
+		old.Spec.MaxReplicas = int32Ptr(2)
+		updated := old.DeepCopy()
+		updated.Spec.Version = "2.4.48-5"
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for changed field with configuration error of the old dogu resource", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		old.Spec.MinReplicas = int32Ptr(3)
+		old.Spec.MaxReplicas = int32Ptr(2)
+		updated := old.DeepCopy()
+		updated.Spec.MaxReplicas = int32Ptr(1)
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.maxReplicas", errs[0].Field)
+		assert.Equal(t, int32(1), errs[0].BadValue)
+	})
+	t.Run("should fail for new configuration error besides configuration error of the old dogu resource", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		old.Spec.MinReplicas = int32Ptr(3)
+		old.Spec.MaxReplicas = int32Ptr(2)
+		updated := old.DeepCopy()
+		updated.Spec.Version = "invalid"
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.version", errs[0].Field)
+	})
+	t.Run("should fail for changed name", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		updated := newValidDogu()
+		updated.Spec.Name = "premium/ldap"
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.name", errs[0].Field)
+		assert.Contains(t, errs[0].Detail, "dogu name is immutable")
+	})
+	t.Run("should allow namespace switch", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		updated := newValidDogu()
+		updated.Spec.Name = "premium/ldap"
+		updated.Spec.UpgradeConfig.AllowNamespaceSwitch = true
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for changed simple name despite namespace switch", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		updated := newValidDogu()
+		updated.Spec.Name = "premium/openldap"
+		updated.Spec.UpgradeConfig.AllowNamespaceSwitch = true
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Detail, "only the namespace of the dogu may be changed")
+	})
+	t.Run("should succeed for unchanged volume size below current volume size", func(t *testing.T) {
+		// given
+		old := &Dogu{Spec: DoguSpec{Name: "official/ldap", Version: "2.4.48-4"}}
+		size := resource.MustParse("10Gi")
+		old.Status.DataVolumeSize = &size
+		updated := old.DeepCopy()
+		updated.Default()
+		updated.Spec.Version = "2.4.48-5"
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should succeed for increased volume size", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		updated := newValidDogu()
+		updated.Spec.Resources.MinDataVolumeSize = resource.MustParse("5Gi")
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for lowered volume size", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		old.Spec.Resources.MinDataVolumeSize = resource.MustParse("5Gi")
+		updated := newValidDogu()
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.minDataVolumeSize", errs[0].Field)
+		assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+		assert.Contains(t, errs[0].Detail, "cannot be shrunk from 5Gi to 2Gi")
+	})
+	t.Run("should succeed for volume size increased below current volume size", func(t *testing.T) {
+		// given
+		old := newValidDogu()
+		size := resource.MustParse("10Gi")
+		old.Status.DataVolumeSize = &size
+		updated := old.DeepCopy()
+		updated.Spec.Resources.MinDataVolumeSize = resource.MustParse("3Gi")
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should succeed for unchanged storage class", func(t *testing.T) {
+		// given
+		storageClass := "longhorn"
+		old := newValidDogu()
+		old.Spec.Resources.StorageClassName = &storageClass
+		updated := newValidDogu()
+		updated.Spec.Resources.StorageClassName = &storageClass
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for changed storage class", func(t *testing.T) {
+		// given
+		oldStorageClass := "longhorn"
+		newStorageClass := "nfs"
+		old := newValidDogu()
+		old.Spec.Resources.StorageClassName = &oldStorageClass
+		updated := newValidDogu()
+		updated.Spec.Resources.StorageClassName = &newStorageClass
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.storageClassName", errs[0].Field)
+		assert.Contains(t, errs[0].Detail, "field is immutable")
+	})
+	t.Run("should fail for changed access modes and volume mode", func(t *testing.T) {
+		// given
+		filesystem, block := corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeBlock
+		old := newValidDogu()
+		old.Spec.Resources.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+		old.Spec.Resources.VolumeMode = &filesystem
+		updated := newValidDogu()
+		updated.Spec.Resources.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+		updated.Spec.Resources.VolumeMode = &block
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 2)
+		assert.Equal(t, "spec.resources.accessModes", errs[0].Field)
+		assert.Contains(t, errs[0].Detail, "field is immutable")
+		assert.Equal(t, "spec.resources.volumeMode", errs[1].Field)
+		assert.Contains(t, errs[1].Detail, "field is immutable")
+	})
+	t.Run("should fail for storage class set after creation", func(t *testing.T) {
+		// given
+		storageClass := "longhorn"
+		old := newValidDogu()
+		updated := newValidDogu()
+		updated.Spec.Resources.StorageClassName = &storageClass
+
+		// when
+		errs := updated.ValidateUpdate(old)
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.resources.storageClassName", errs[0].Field)
+	})
+}
+
+func TestDogu_ValidateWithDescriptor(t *testing.T) {
+	t.Run("should succeed without descriptor", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.ConfigOverrides = map[string]string{"unknown": "value"}
+
+		// when
+		errs := dogu.ValidateWithDescriptor(nil)
+
+		// then
+		assert.Empty(t, errs)
+	})
+	t.Run("should fail for unknown config override", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.ConfigOverrides = map[string]string{"unknown": "value"}
+
+		// when
+		errs := dogu.ValidateWithDescriptor(&core.Dogu{Name: "official/ldap"})
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.configOverrides[unknown]", errs[0].Field)
+	})
+	t.Run("should fail for mount into unknown volume", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.AdditionalMounts = []DataMount{{SourceType: DataSourceConfigMap, Name: "my-configmap", Volume: "unknown"}}
+
+		// when
+		errs := dogu.ValidateWithDescriptor(&core.Dogu{Name: "official/ldap"})
+
+		// then
+		require.Len(t, errs, 1)
+		assert.Equal(t, "spec.additionalMounts[0].volume", errs[0].Field)
+	})
+}
+
+func TestDoguValidator(t *testing.T) {
+	sut := &DoguValidator{}
+
+	t.Run("should accept valid dogu on create", func(t *testing.T) {
+		// when
+		warnings, err := sut.ValidateCreate(context.TODO(), newValidDogu())
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+	t.Run("should reject invalid dogu on create", func(t *testing.T) {
+		// given
+		dogu := newValidDogu()
+		dogu.Spec.Version = "invalid"
+
+		// when
+		_, err := sut.ValidateCreate(context.TODO(), dogu)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apierrors.IsInvalid(err))
+		assert.ErrorContains(t, err, `Dogu.k8s.cloudogu.com "ldap" is invalid: spec.version`)
+	})
+	t.Run("should reject name change on update", func(t *testing.T) {
+		// given
+		updated := newValidDogu()
+		updated.Spec.Name = "premium/ldap"
+
+		// when
+		_, err := sut.ValidateUpdate(context.TODO(), newValidDogu(), updated)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apierrors.IsInvalid(err))
+		assert.ErrorContains(t, err, "dogu name is immutable")
+	})
+	t.Run("should reject other objects", func(t *testing.T) {
+		// when
+		_, err := sut.ValidateCreate(context.TODO(), &DoguRestart{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected a dogu but got *v2.DoguRestart")
+	})
+	t.Run("should allow delete", func(t *testing.T) {
+		// when
+		_, err := sut.ValidateDelete(context.TODO(), newValidDogu())
+
+		// then
+		require.NoError(t, err)
+	})
+}
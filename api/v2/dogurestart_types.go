@@ -5,39 +5,160 @@ This file was generated with "make generate-deepcopy".
 package v2
 
 import (
+	"fmt"
+	"slices"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// interface constraints
+var _ conditions.Getter = &DoguRestart{}
+var _ conditions.Setter = &DoguRestart{}
+
 // DoguRestartSpec defines the desired state of DoguRestart
 type DoguRestartSpec struct {
 	// DoguName references the dogu that should get restarted.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Dogu name is immutable"
 	DoguName string `json:"doguName"`
+	// NotBefore defers the restart until the given point in time.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// Schedule defers the restart until the next point in time matching the given cron expression
+	// in the standard five field format "minute hour day-of-month month day-of-week", e.g. "0 2 * * sun".
+	// The schedule is evaluated in UTC from the creation of the dogu restart. If NotBefore is set as well, the
+	// restart is executed at the first matching point in time after NotBefore.
+	// +kubebuilder:validation:Pattern=`^(@(yearly|annually|monthly|weekly|daily|midnight|hourly)|[0-9a-zA-Z*,/-]+( +[0-9a-zA-Z*,/-]+){4})$`
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// TTLSecondsAfterFinished limits the lifetime of the dogu restart after it completed or failed.
+	// After the TTL expired, the dogu restart may be deleted. If this field is unset, the dogu restart is kept.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// Validate checks the restart spec for configuration errors.
+func (drs DoguRestartSpec) Validate() error {
+	if drs.Schedule == "" {
+		return nil
+	}
+
+	schedule, err := ParseCronSchedule(drs.Schedule)
+	if err != nil {
+		return err
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		return fmt.Errorf("cron expression %q never matches", drs.Schedule)
+	}
+
+	return nil
+}
+
+// NextExecutionTime returns the earliest point in time at which the restart may be executed, considering
+// NotBefore and Schedule. The execution time is computed from the given creation time of the restart, or from
+// NotBefore if it is later, so that it does not move while the restart waits for its execution. The restart is due
+// once the returned time is not after the current time, see DoguRestart.IsDue. The anchor time is returned if the
+// restart is not scheduled. An error is returned if the schedule is invalid or never matches.
+func (drs DoguRestartSpec) NextExecutionTime(createdAt time.Time) (time.Time, error) {
+	anchor := createdAt
+	if drs.NotBefore != nil && drs.NotBefore.After(anchor) {
+		anchor = drs.NotBefore.Time
+	}
+
+	if drs.Schedule == "" {
+		return anchor, nil
+	}
+
+	schedule, err := ParseCronSchedule(drs.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	scheduled := schedule.Next(anchor.UTC())
+	if scheduled.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression %q never matches after %s", drs.Schedule, anchor.UTC().Format(time.RFC3339))
+	}
+
+	return scheduled, nil
 }
 
 // DoguRestartStatus defines the observed state of DoguRestart
 type DoguRestartStatus struct {
 	// Phase tracks the state of the restart process.
 	Phase RestartStatusPhase `json:"phase,omitempty"`
+	// StartedAt is the point in time at which the restart process started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is the point in time at which the restart process completed or failed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Message is a human-readable message about the current phase, e.g. the reason why the restart failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// ObservedGeneration is the generation of the dogu restart which was last processed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions describe the current state of the restart process.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
+// These constants are exported for use in other packages
+// nolint:unused
+//
+//goland:noinspection GoUnusedConst
+const (
+	// RestartConditionSucceeded is true if the restart completed and false if the restart failed.
+	RestartConditionSucceeded = "succeeded"
+)
+
 type RestartStatusPhase string
 
+// IsFailed checks if the restart process ended in a failure phase.
 func (rsp RestartStatusPhase) IsFailed() bool {
-	return rsp != RestartStatusPhaseNew && !rsp.isInProgress() && !rsp.isSuccessful()
+	return rsp != RestartStatusPhaseNew && !rsp.IsInProgress() && !rsp.IsSuccessful()
 }
 
-func (rsp RestartStatusPhase) isInProgress() bool {
+// IsInProgress checks if the restart process is currently stopping or starting the dogu.
+func (rsp RestartStatusPhase) IsInProgress() bool {
 	return rsp == RestartStatusPhaseStarting || rsp == RestartStatusPhaseStopping || rsp == RestartStatusPhaseStopped
 }
 
-func (rsp RestartStatusPhase) isSuccessful() bool {
+// IsSuccessful checks if the restart process completed.
+func (rsp RestartStatusPhase) IsSuccessful() bool {
 	return rsp == RestartStatusPhaseCompleted
 }
 
+// IsTerminal checks if the restart process is finished, either successfully or with a failure.
+// A terminal phase cannot transition to any other phase.
+func (rsp RestartStatusPhase) IsTerminal() bool {
+	return rsp.IsSuccessful() || rsp.IsFailed()
+}
+
+// CanTransitionTo checks if the restart process may change from this phase to the given phase.
+// Staying in the current phase is always allowed.
+func (rsp RestartStatusPhase) CanTransitionTo(next RestartStatusPhase) bool {
+	return rsp == next || slices.Contains(restartPhaseTransitions[rsp], next)
+}
+
+// restartPhaseTransitions contains the allowed transitions between the phases of a restart process.
+// The dogu can be deleted at any time, so every phase in progress may end with the dogu not being found.
+var restartPhaseTransitions = map[RestartStatusPhase][]RestartStatusPhase{
+	RestartStatusPhaseNew:      {RestartStatusPhaseStopping, RestartStatusPhaseDoguNotFound, RestartStatusPhaseFailedGetDogu},
+	RestartStatusPhaseStopping: {RestartStatusPhaseStopped, RestartStatusPhaseFailedStop, RestartStatusPhaseDoguNotFound, RestartStatusPhaseFailedGetDogu},
+	RestartStatusPhaseStopped:  {RestartStatusPhaseStarting, RestartStatusPhaseFailedStart, RestartStatusPhaseDoguNotFound, RestartStatusPhaseFailedGetDogu},
+	RestartStatusPhaseStarting: {RestartStatusPhaseCompleted, RestartStatusPhaseFailedStart, RestartStatusPhaseDoguNotFound, RestartStatusPhaseFailedGetDogu},
+}
+
 const (
 	RestartStatusPhaseNew           RestartStatusPhase = ""
 	RestartStatusPhaseStopping      RestartStatusPhase = "stopping"
@@ -55,6 +176,10 @@ const (
 // +kubebuilder:resource:shortName="dr"
 // +kubebuilder:printcolumn:name="Dogu",type="string",JSONPath=".spec.doguName",description="The name of the dogu"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The current phase of the dogu restart"
+// +kubebuilder:printcolumn:name="Succeeded",type="string",JSONPath=".status.conditions[?(@.type=='succeeded')].status",description="Whether the dogu restart succeeded"
+// +kubebuilder:printcolumn:name="Started",type="date",JSONPath=".status.startedAt",description="The time the dogu restart started"
+// +kubebuilder:printcolumn:name="Completed",type="date",JSONPath=".status.completedAt",description="The time the dogu restart completed or failed"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.message",description="The message of the current phase"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="The age of the resource"
 
 // DoguRestart is the Schema for the dogurestarts API
@@ -66,6 +191,70 @@ type DoguRestart struct {
 	Status DoguRestartStatus `json:"status,omitempty"`
 }
 
+// TransitionTo changes the phase of the restart process to the given phase. When the restart leaves the new phase,
+// the start time is set, and when it reaches a terminal phase, the completion time is set.
+// An error is returned and the status is left unchanged if the transition is not allowed.
+func (dr *DoguRestart) TransitionTo(next RestartStatusPhase) error {
+	current := dr.Status.Phase
+	if !current.CanTransitionTo(next) {
+		return fmt.Errorf("illegal transition of dogu restart %s from phase %q to %q", dr.Name, current, next)
+	}
+
+	now := metav1.Now()
+	if dr.Status.StartedAt == nil && next != RestartStatusPhaseNew {
+		dr.Status.StartedAt = &now
+	}
+	if dr.Status.CompletedAt == nil && next.IsTerminal() {
+		dr.Status.CompletedAt = &now
+	}
+	dr.Status.Phase = next
+
+	return nil
+}
+
+// FinishedAt returns the point in time at which the restart process completed or failed. It returns false if the
+// restart is not finished yet. If the completion time is missing, the creation time is used instead.
+func (dr *DoguRestart) FinishedAt() (time.Time, bool) {
+	if !dr.Status.Phase.IsTerminal() {
+		return time.Time{}, false
+	}
+	if dr.Status.CompletedAt != nil {
+		return dr.Status.CompletedAt.Time, true
+	}
+
+	return dr.CreationTimestamp.Time, true
+}
+
+// IsDue checks if the restart may be executed at the given time. The execution time is computed from the creation
+// time of the restart, see DoguRestartSpec.NextExecutionTime.
+func (dr *DoguRestart) IsDue(now time.Time) (bool, error) {
+	next, err := dr.Spec.NextExecutionTime(dr.CreationTimestamp.Time)
+	if err != nil {
+		return false, err
+	}
+
+	return !next.After(now), nil
+}
+
+// IsExpired checks if the restart process is finished and its TTLSecondsAfterFinished expired at the given time.
+func (dr *DoguRestart) IsExpired(now time.Time) bool {
+	finishedAt, finished := dr.FinishedAt()
+	if !finished || dr.Spec.TTLSecondsAfterFinished == nil {
+		return false
+	}
+
+	ttl := time.Duration(*dr.Spec.TTLSecondsAfterFinished) * time.Second
+	return !now.Before(finishedAt.Add(ttl))
+}
+
+func (dr *DoguRestart) GetConditions() []metav1.Condition {
+	return dr.Status.Conditions
+}
+
+func (dr *DoguRestart) SetConditions(c []metav1.Condition) {
+	dr.Status.Conditions = c
+}
+
 // +kubebuilder:object:root=true
 
 // DoguRestartList contains a list of DoguRestart
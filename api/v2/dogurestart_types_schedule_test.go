@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	// Saturday, 2025-11-15 10:17:30 UTC
+	now := time.Date(2025, 11, 15, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		want       time.Time
+	}{
+		{name: "every minute", expression: "* * * * *", want: time.Date(2025, 11, 15, 10, 18, 0, 0, time.UTC)},
+		{name: "steps", expression: "*/15 * * * *", want: time.Date(2025, 11, 15, 10, 30, 0, 0, time.UTC)},
+		{name: "range with steps", expression: "0-30/10 11 * * *", want: time.Date(2025, 11, 15, 11, 0, 0, 0, time.UTC)},
+		{name: "list", expression: "5,45 10 * * *", want: time.Date(2025, 11, 15, 10, 45, 0, 0, time.UTC)},
+		{name: "next day", expression: "0 2 * * *", want: time.Date(2025, 11, 16, 2, 0, 0, 0, time.UTC)},
+		{name: "day of week name", expression: "0 2 * * mon", want: time.Date(2025, 11, 17, 2, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expression: "0 2 * * 7", want: time.Date(2025, 11, 16, 2, 0, 0, 0, time.UTC)},
+		{name: "weekday range", expression: "30 3 * * 1-5", want: time.Date(2025, 11, 17, 3, 30, 0, 0, time.UTC)},
+		{name: "month name", expression: "0 0 1 jan *", want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or day of week", expression: "0 0 20 * fri", want: time.Date(2025, 11, 20, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expression: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "macro", expression: "@monthly", want: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "never matching", expression: "0 0 30 2 *", want: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			schedule, err := ParseCronSchedule(tt.expression)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(now))
+		})
+	}
+
+	t.Run("should return given time if it matches exactly", func(t *testing.T) {
+		// given
+		schedule, err := ParseCronSchedule("0 2 * * *")
+		require.NoError(t, err)
+		exact := time.Date(2025, 11, 15, 2, 0, 0, 0, time.UTC)
+
+		// when
+		actual := schedule.Next(exact)
+
+		// then
+		assert.Equal(t, exact, actual)
+	})
+
+	invalidTests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{name: "too few fields", expression: "0 2 * *", wantErr: "expected 5 fields but got 4"},
+		{name: "out of range", expression: "60 * * * *", wantErr: "value 60 in minute field is out of range 0-59"},
+		{name: "invalid value", expression: "* * * foo *", wantErr: `invalid value "foo" in month field`},
+		{name: "invalid step", expression: "*/0 * * * *", wantErr: `invalid step "0" in minute field`},
+		{name: "inverted range", expression: "* 5-1 * * *", wantErr: `invalid range "5-1" in hour field`},
+	}
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := ParseCronSchedule(tt.expression)
+
+			// then
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestDoguRestartSpec_Validate(t *testing.T) {
+	t.Run("should succeed without schedule", func(t *testing.T) {
+		// when
+		err := DoguRestartSpec{DoguName: "ldap"}.Validate()
+
+		// then
+		assert.NoError(t, err)
+	})
+	t.Run("should succeed with valid schedule", func(t *testing.T) {
+		// when
+		err := DoguRestartSpec{DoguName: "ldap", Schedule: "0 2 * * sun"}.Validate()
+
+		// then
+		assert.NoError(t, err)
+	})
+	t.Run("should fail with invalid schedule", func(t *testing.T) {
+		// when
+		err := DoguRestartSpec{DoguName: "ldap", Schedule: "0 25 * * *"}.Validate()
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "value 25 in hour field is out of range 0-23")
+	})
+	t.Run("should fail with schedule that never matches", func(t *testing.T) {
+		// when
+		err := DoguRestartSpec{DoguName: "ldap", Schedule: "0 0 31 4 *"}.Validate()
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `cron expression "0 0 31 4 *" never matches`)
+	})
+}
+
+func TestDoguRestartSpec_NextExecutionTime(t *testing.T) {
+	createdAt := time.Date(2025, 11, 15, 10, 17, 30, 0, time.UTC)
+
+	t.Run("should return creation time for immediate restart", func(t *testing.T) {
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap"}.NextExecutionTime(createdAt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, createdAt, actual)
+	})
+	t.Run("should return creation time if notBefore is before creation", func(t *testing.T) {
+		// given
+		notBefore := metav1.NewTime(createdAt.Add(-time.Hour))
+
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap", NotBefore: &notBefore}.NextExecutionTime(createdAt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, createdAt, actual)
+	})
+	t.Run("should return notBefore", func(t *testing.T) {
+		// given
+		notBefore := metav1.NewTime(createdAt.Add(time.Hour))
+
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap", NotBefore: &notBefore}.NextExecutionTime(createdAt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, notBefore.Time, actual)
+	})
+	t.Run("should return next scheduled time", func(t *testing.T) {
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap", Schedule: "0 2 * * *"}.NextExecutionTime(createdAt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 11, 16, 2, 0, 0, 0, time.UTC), actual)
+	})
+	t.Run("should return scheduled time matching the creation time", func(t *testing.T) {
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap", Schedule: "17 10 * * *"}.NextExecutionTime(createdAt.Truncate(time.Minute))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 11, 15, 10, 17, 0, 0, time.UTC), actual)
+	})
+	t.Run("should return next scheduled time after notBefore", func(t *testing.T) {
+		// given
+		notBefore := metav1.NewTime(time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC))
+
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap", NotBefore: &notBefore, Schedule: "0 2 * * *"}.NextExecutionTime(createdAt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 11, 21, 2, 0, 0, 0, time.UTC), actual)
+	})
+	t.Run("should evaluate schedule in UTC", func(t *testing.T) {
+		// given
+		berlin := time.FixedZone("CET", 60*60)
+
+		// when
+		actual, err := DoguRestartSpec{DoguName: "ldap", Schedule: "0 2 * * *"}.NextExecutionTime(createdAt.In(berlin))
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, time.Date(2025, 11, 16, 2, 0, 0, 0, time.UTC).Equal(actual))
+	})
+	t.Run("should fail for invalid schedule", func(t *testing.T) {
+		// when
+		_, err := DoguRestartSpec{DoguName: "ldap", Schedule: "invalid"}.NextExecutionTime(createdAt)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected 5 fields but got 1")
+	})
+	t.Run("should fail for schedule that never matches", func(t *testing.T) {
+		// when
+		_, err := DoguRestartSpec{DoguName: "ldap", Schedule: "0 0 30 2 *"}.NextExecutionTime(createdAt)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `cron expression "0 0 30 2 *" never matches after 2025-11-15T10:17:30Z`)
+	})
+}
+
+func TestDoguRestart_IsDue(t *testing.T) {
+	createdAt := metav1.NewTime(time.Date(2025, 11, 16, 1, 59, 30, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		restart DoguRestart
+		now     time.Time
+		want    bool
+	}{
+		{
+			name:    "immediate restart",
+			restart: DoguRestart{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: createdAt}},
+			now:     createdAt.Time,
+			want:    true,
+		},
+		{
+			name:    "before scheduled time",
+			restart: DoguRestart{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: createdAt}, Spec: DoguRestartSpec{Schedule: "0 2 * * *"}},
+			now:     time.Date(2025, 11, 16, 1, 59, 59, 0, time.UTC),
+			want:    false,
+		},
+		{
+			name:    "seconds after scheduled time",
+			restart: DoguRestart{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: createdAt}, Spec: DoguRestartSpec{Schedule: "0 2 * * *"}},
+			now:     time.Date(2025, 11, 16, 2, 0, 5, 0, time.UTC),
+			want:    true,
+		},
+		{
+			name:    "days after scheduled time",
+			restart: DoguRestart{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: createdAt}, Spec: DoguRestartSpec{Schedule: "0 2 * * *"}},
+			now:     time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC),
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			actual, err := tt.restart.IsDue(tt.now)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, actual)
+		})
+	}
+	t.Run("should fail for invalid schedule", func(t *testing.T) {
+		// given
+		restart := DoguRestart{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: createdAt}, Spec: DoguRestartSpec{Schedule: "invalid"}}
+
+		// when
+		actual, err := restart.IsDue(createdAt.Time)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected 5 fields but got 1")
+		assert.False(t, actual)
+	})
+}
+
+func TestDoguRestart_GetConditions(t *testing.T) {
+	restart := DoguRestart{
+		Status: DoguRestartStatus{
+			Conditions: []metav1.Condition{
+				{Type: RestartConditionSucceeded},
+			},
+		},
+	}
+
+	actual := restart.GetConditions()
+
+	assert.Equal(t, RestartConditionSucceeded, actual[0].Type)
+}
+
+func TestDoguRestart_SetConditions(t *testing.T) {
+	restart := DoguRestart{}
+
+	restart.SetConditions([]metav1.Condition{
+		{Type: RestartConditionSucceeded},
+	})
+
+	assert.Equal(t, RestartConditionSucceeded, restart.Status.Conditions[0].Type)
+}
+
+func TestDoguRestart_implementsConditionsGetterAndSetter(t *testing.T) {
+	restart := &DoguRestart{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+	conditions.Set(restart, metav1.Condition{Type: RestartConditionSucceeded, Status: metav1.ConditionFalse, Reason: "StartFailed"})
+
+	assert.True(t, conditions.IsFalse(restart, RestartConditionSucceeded))
+	assert.Equal(t, int64(2), restart.Status.Conditions[0].ObservedGeneration)
+}
+
+func TestRestartStatusPhase_predicates(t *testing.T) {
+	tests := []struct {
+		phase      RestartStatusPhase
+		inProgress bool
+		successful bool
+		failed     bool
+	}{
+		{phase: RestartStatusPhaseNew},
+		{phase: RestartStatusPhaseStopping, inProgress: true},
+		{phase: RestartStatusPhaseStopped, inProgress: true},
+		{phase: RestartStatusPhaseStarting, inProgress: true},
+		{phase: RestartStatusPhaseCompleted, successful: true},
+		{phase: RestartStatusPhaseDoguNotFound, failed: true},
+		{phase: RestartStatusPhaseFailedGetDogu, failed: true},
+		{phase: RestartStatusPhaseFailedStop, failed: true},
+		{phase: RestartStatusPhaseFailedStart, failed: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.phase), func(t *testing.T) {
+			assert.Equal(t, tt.inProgress, tt.phase.IsInProgress())
+			assert.Equal(t, tt.successful, tt.phase.IsSuccessful())
+			assert.Equal(t, tt.failed, tt.phase.IsFailed())
+			assert.Equal(t, tt.successful || tt.failed, tt.phase.IsTerminal())
+		})
+	}
+}
+
+func TestRestartStatusPhase_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from RestartStatusPhase
+		to   RestartStatusPhase
+		want bool
+	}{
+		{from: RestartStatusPhaseNew, to: RestartStatusPhaseStopping, want: true},
+		{from: RestartStatusPhaseNew, to: RestartStatusPhaseDoguNotFound, want: true},
+		{from: RestartStatusPhaseNew, to: RestartStatusPhaseStarting, want: false},
+		{from: RestartStatusPhaseStopping, to: RestartStatusPhaseStopped, want: true},
+		{from: RestartStatusPhaseStopping, to: RestartStatusPhaseFailedStop, want: true},
+		{from: RestartStatusPhaseStopping, to: RestartStatusPhaseCompleted, want: false},
+		{from: RestartStatusPhaseStopped, to: RestartStatusPhaseStarting, want: true},
+		{from: RestartStatusPhaseStarting, to: RestartStatusPhaseCompleted, want: true},
+		{from: RestartStatusPhaseStarting, to: RestartStatusPhaseFailedStart, want: true},
+		{from: RestartStatusPhaseStarting, to: RestartStatusPhaseStopping, want: false},
+		{from: RestartStatusPhaseStarting, to: RestartStatusPhaseStarting, want: true},
+		{from: RestartStatusPhaseCompleted, to: RestartStatusPhaseStopping, want: false},
+		{from: RestartStatusPhaseCompleted, to: RestartStatusPhaseCompleted, want: true},
+		{from: RestartStatusPhaseFailedStop, to: RestartStatusPhaseStarting, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%q to %q", tt.from, tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestDoguRestart_TransitionTo(t *testing.T) {
+	t.Run("should set phase and start time", func(t *testing.T) {
+		// given
+		restart := &DoguRestart{}
+
+		// when
+		err := restart.TransitionTo(RestartStatusPhaseStopping)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, RestartStatusPhaseStopping, restart.Status.Phase)
+		assert.NotNil(t, restart.Status.StartedAt)
+		assert.Nil(t, restart.Status.CompletedAt)
+	})
+	t.Run("should keep start time and set completion time on terminal phase", func(t *testing.T) {
+		// given
+		startedAt := metav1.NewTime(time.Date(2025, 11, 15, 2, 0, 0, 0, time.UTC))
+		restart := &DoguRestart{Status: DoguRestartStatus{Phase: RestartStatusPhaseStarting, StartedAt: &startedAt}}
+
+		// when
+		err := restart.TransitionTo(RestartStatusPhaseFailedStart)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, RestartStatusPhaseFailedStart, restart.Status.Phase)
+		assert.Equal(t, &startedAt, restart.Status.StartedAt)
+		assert.NotNil(t, restart.Status.CompletedAt)
+	})
+	t.Run("should fail on illegal transition", func(t *testing.T) {
+		// given
+		restart := &DoguRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart-abcde"},
+			Status:     DoguRestartStatus{Phase: RestartStatusPhaseCompleted},
+		}
+
+		// when
+		err := restart.TransitionTo(RestartStatusPhaseStopping)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `illegal transition of dogu restart ldap-restart-abcde from phase "completed" to "stopping"`)
+		assert.Equal(t, RestartStatusPhaseCompleted, restart.Status.Phase)
+		assert.Nil(t, restart.Status.CompletedAt)
+	})
+}
+
+func TestDoguRestart_IsExpired(t *testing.T) {
+	now := time.Date(2025, 11, 15, 12, 0, 0, 0, time.UTC)
+	ttl := int32(60)
+	completedAt := metav1.NewTime(now.Add(-time.Minute))
+	createdAt := metav1.NewTime(now.Add(-30 * time.Second))
+
+	tests := []struct {
+		name    string
+		restart DoguRestart
+		want    bool
+	}{
+		{
+			name:    "not finished",
+			restart: DoguRestart{Spec: DoguRestartSpec{TTLSecondsAfterFinished: &ttl}, Status: DoguRestartStatus{Phase: RestartStatusPhaseStarting}},
+			want:    false,
+		},
+		{
+			name:    "without ttl",
+			restart: DoguRestart{Status: DoguRestartStatus{Phase: RestartStatusPhaseCompleted, CompletedAt: &completedAt}},
+			want:    false,
+		},
+		{
+			name:    "expired",
+			restart: DoguRestart{Spec: DoguRestartSpec{TTLSecondsAfterFinished: &ttl}, Status: DoguRestartStatus{Phase: RestartStatusPhaseFailedStop, CompletedAt: &completedAt}},
+			want:    true,
+		},
+		{
+			name: "not expired based on creation time",
+			restart: DoguRestart{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: createdAt},
+				Spec:       DoguRestartSpec{TTLSecondsAfterFinished: &ttl},
+				Status:     DoguRestartStatus{Phase: RestartStatusPhaseCompleted},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.restart.IsExpired(now))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 // Package v2 contains API Schema definitions for the k8s v2 API group
 // +kubebuilder:object:generate=true
 // +groupName=k8s.cloudogu.com
+// +kubebuilder:ac:generate=true
+// +kubebuilder:ac:output:package="../../client/applyconfiguration"
 package v2
 
 import (
@@ -12,6 +14,9 @@ var (
 	// GroupVersion is group version used to register these objects
 	GroupVersion = schema.GroupVersion{Group: "k8s.cloudogu.com", Version: "v2"}
 
+	// SchemeGroupVersion is an alias of GroupVersion which is expected by generated code like the apply configurations.
+	SchemeGroupVersion = GroupVersion
+
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
 	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
 
@@ -3,6 +3,8 @@ package v2
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	cloudoguerrors "github.com/cloudogu/ces-commons-lib/errors"
 
@@ -10,22 +12,160 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// GetPodForLabels returns a pod for the given dogu labels. An error is returned if either no pod or more than one pod is found.
-func GetPodForLabels(ctx context.Context, cli client.Client, doguLabels CesMatchingLabels) (*v1.Pod, error) {
-	// note for future improvement:
-	// this pod's selection must be revised if dogus are horizontally scalable by adding more pods with the same image.
+// DoguLeaderAnnotation marks the leader pod of a horizontally scaled dogu if its value is "true".
+const DoguLeaderAnnotation = "k8s.cloudogu.com/dogu-leader"
+
+// PodSelectionMode defines how a single pod is selected if more than one pod matches.
+type PodSelectionMode string
+
+// These constants are exported for use in other packages
+// nolint:unused
+//
+//goland:noinspection GoUnusedConst
+const (
+	// PodSelectionExactlyOne fails with an *AmbiguousPodError if more than one pod matches.
+	PodSelectionExactlyOne PodSelectionMode = "ExactlyOne"
+	// PodSelectionPreferReady selects the newest ready pod if more than one pod matches, e.g. during rolling
+	// updates. If no pod is ready, the newest pod is selected.
+	PodSelectionPreferReady PodSelectionMode = "PreferReady"
+)
+
+// PodSelector selects a subset of the given pods. Selectors do not modify the given slice.
+// +kubebuilder:object:generate=false
+type PodSelector func(pods []v1.Pod) []v1.Pod
+
+// ReadyPods selects all pods which are ready and not terminating.
+func ReadyPods() PodSelector {
+	return func(pods []v1.Pod) []v1.Pod {
+		return filterPods(pods, isPodReady)
+	}
+}
+
+// NewestPod selects the pod which was created last. If several pods were created at the same time, the pod with the
+// lexicographically greatest name is selected so that the selection is deterministic.
+func NewestPod() PodSelector {
+	return func(pods []v1.Pod) []v1.Pod {
+		if len(pods) == 0 {
+			return nil
+		}
+
+		newest := slices.MaxFunc(pods, func(a, b v1.Pod) int {
+			if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Name, b.Name)
+		})
+		return []v1.Pod{newest}
+	}
+}
+
+// PodsOnNode selects all pods which are scheduled on the node with the given name.
+func PodsOnNode(nodeName string) PodSelector {
+	return func(pods []v1.Pod) []v1.Pod {
+		return filterPods(pods, func(pod v1.Pod) bool {
+			return pod.Spec.NodeName == nodeName
+		})
+	}
+}
+
+// LeaderPod selects all pods which are marked as leader with the DoguLeaderAnnotation.
+func LeaderPod() PodSelector {
+	return func(pods []v1.Pod) []v1.Pod {
+		return filterPods(pods, func(pod v1.Pod) bool {
+			return pod.Annotations[DoguLeaderAnnotation] == "true"
+		})
+	}
+}
+
+func filterPods(pods []v1.Pod, predicate func(pod v1.Pod) bool) []v1.Pod {
+	var filtered []v1.Pod
+	for _, pod := range pods {
+		if predicate(pod) {
+			filtered = append(filtered, pod)
+		}
+	}
+
+	return filtered
+}
+
+func isPodReady(pod v1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// GetPodsForLabels returns all pods for the given dogu labels which match all given selectors.
+// The selectors are applied in the given order, e.g. ReadyPods() followed by NewestPod() selects the newest ready pod.
+// An empty list is returned if no pod matches.
+func GetPodsForLabels(ctx context.Context, cli client.Client, doguLabels CesMatchingLabels, selectors ...PodSelector) ([]v1.Pod, error) {
 	pods := &v1.PodList{}
 	err := cli.List(ctx, pods, client.MatchingLabels(doguLabels))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pods: %w", err)
 	}
 
-	if len(pods.Items) == 0 {
+	selected := pods.Items
+	for _, selector := range selectors {
+		selected = selector(selected)
+	}
+
+	return selected, nil
+}
+
+// GetPodForLabels returns a pod for the given dogu labels. An error is returned if either no pod or more than one pod is found.
+func GetPodForLabels(ctx context.Context, cli client.Client, doguLabels CesMatchingLabels) (*v1.Pod, error) {
+	return GetPodForLabelsWithMode(ctx, cli, doguLabels, PodSelectionExactlyOne)
+}
+
+// GetPodForLabelsWithMode returns a pod for the given dogu labels. If more than one pod is found, the given mode
+// decides which pod is returned or if an *AmbiguousPodError is returned. An error is returned if no pod is found.
+func GetPodForLabelsWithMode(ctx context.Context, cli client.Client, doguLabels CesMatchingLabels, mode PodSelectionMode) (*v1.Pod, error) {
+	pods, err := GetPodsForLabels(ctx, cli, doguLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pods) == 0 {
 		return nil, cloudoguerrors.NewNotFoundError(fmt.Errorf("found no pods for labels %s", doguLabels))
 	}
-	if len(pods.Items) > 1 {
-		return nil, fmt.Errorf("found more than one pod (%s) for labels %s", pods, doguLabels)
+	if len(pods) == 1 {
+		return &pods[0], nil
 	}
 
-	return &pods.Items[0], nil
+	switch mode {
+	case PodSelectionPreferReady:
+		candidates := ReadyPods()(pods)
+		if len(candidates) == 0 {
+			candidates = pods
+		}
+		return &NewestPod()(candidates)[0], nil
+	default:
+		podNames := make([]string, 0, len(pods))
+		for _, pod := range pods {
+			podNames = append(podNames, pod.Name)
+		}
+		return nil, &AmbiguousPodError{Labels: doguLabels, PodNames: podNames}
+	}
+}
+
+// AmbiguousPodError is returned if more than one pod was found where exactly one pod was expected.
+// +kubebuilder:object:generate=false
+type AmbiguousPodError struct {
+	// Labels are the labels used to select the pod.
+	Labels CesMatchingLabels
+	// PodNames are the names of all found pods.
+	PodNames []string
+}
+
+// Error returns the error message including the names of the found pods.
+func (e *AmbiguousPodError) Error() string {
+	return fmt.Sprintf("found more than one pod (%s) for labels %s", strings.Join(e.PodNames, ", "), e.Labels)
 }
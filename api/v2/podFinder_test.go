@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"testing"
+	"time"
 
 	cloudoguerrors "github.com/cloudogu/ces-commons-lib/errors"
 	"github.com/stretchr/testify/assert"
@@ -89,7 +90,203 @@ func TestGetPodForLabels(t *testing.T) {
 
 		// then
 		require.Error(t, err)
-		assert.ErrorContains(t, err, "found more than one pod")
+		assert.ErrorContains(t, err, "found more than one pod (ldap-1, ldap-2) for labels")
+		var ambiguousErr *AmbiguousPodError
+		require.ErrorAs(t, err, &ambiguousErr)
+		assert.Equal(t, labels, ambiguousErr.Labels)
+		assert.Equal(t, []string{"ldap-1", "ldap-2"}, ambiguousErr.PodNames)
+	})
+}
+
+func newPodFinderTestPod(name string, created time.Time, ready bool) corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            CesMatchingLabels{DoguLabelName: "ldap", DoguLabelVersion: "1.2.3-4"},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}},
+	}
+}
+
+func podNames(pods []corev1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestPodSelectors(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	older := newPodFinderTestPod("ldap-older", now.Add(-time.Hour), true)
+	newer := newPodFinderTestPod("ldap-newer", now, false)
+	terminating := newPodFinderTestPod("ldap-terminating", now.Add(-2*time.Hour), true)
+	terminating.DeletionTimestamp = &metav1.Time{Time: now}
+	pods := []corev1.Pod{older, newer, terminating}
+
+	t.Run("ReadyPods should select ready pods which are not terminating", func(t *testing.T) {
+		assert.Equal(t, []string{"ldap-older"}, podNames(ReadyPods()(pods)))
+	})
+	t.Run("NewestPod should select newest pod", func(t *testing.T) {
+		assert.Equal(t, []string{"ldap-newer"}, podNames(NewestPod()(pods)))
+	})
+	t.Run("NewestPod should select by name for equal creation timestamps", func(t *testing.T) {
+		// given
+		first := newPodFinderTestPod("ldap-a", now, true)
+		second := newPodFinderTestPod("ldap-b", now, true)
+
+		// when
+		actual := NewestPod()([]corev1.Pod{second, first})
+
+		// then
+		assert.Equal(t, []string{"ldap-b"}, podNames(actual))
+	})
+	t.Run("NewestPod should select nothing for no pods", func(t *testing.T) {
+		assert.Empty(t, NewestPod()(nil))
+	})
+	t.Run("PodsOnNode should select pods on node", func(t *testing.T) {
+		// given
+		onNode := newPodFinderTestPod("ldap-on-node", now, true)
+		onNode.Spec.NodeName = "node-1"
+
+		// when
+		actual := PodsOnNode("node-1")(append([]corev1.Pod{onNode}, pods...))
+
+		// then
+		assert.Equal(t, []string{"ldap-on-node"}, podNames(actual))
+	})
+	t.Run("LeaderPod should select annotated pods", func(t *testing.T) {
+		// given
+		leader := newPodFinderTestPod("ldap-leader", now, true)
+		leader.Annotations = map[string]string{DoguLeaderAnnotation: "true"}
+		follower := newPodFinderTestPod("ldap-follower", now, true)
+		follower.Annotations = map[string]string{DoguLeaderAnnotation: "false"}
+
+		// when
+		actual := LeaderPod()([]corev1.Pod{leader, follower, older})
+
+		// then
+		assert.Equal(t, []string{"ldap-leader"}, podNames(actual))
+	})
+}
+
+func TestGetPodsForLabels(t *testing.T) {
+	var testCtx = context.Background()
+	labels := CesMatchingLabels{DoguLabelName: "ldap", DoguLabelVersion: "1.2.3-4"}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should return all pods", func(t *testing.T) {
+		// given
+		pod1 := newPodFinderTestPod("ldap-1", now, true)
+		pod2 := newPodFinderTestPod("ldap-2", now, false)
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).WithObjects(&pod1, &pod2).Build()
+
+		// when
+		actual, err := GetPodsForLabels(testCtx, cli, labels)
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"ldap-1", "ldap-2"}, podNames(actual))
+	})
+	t.Run("should apply selectors in order", func(t *testing.T) {
+		// given
+		older := newPodFinderTestPod("ldap-older", now.Add(-time.Hour), true)
+		newer := newPodFinderTestPod("ldap-newer", now, false)
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).WithObjects(&older, &newer).Build()
+
+		// when
+		actual, err := GetPodsForLabels(testCtx, cli, labels, ReadyPods(), NewestPod())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ldap-older"}, podNames(actual))
+	})
+	t.Run("should return error on list error", func(t *testing.T) {
+		// given
+		cli := newMockK8sClient(t)
+		cli.On("List", testCtx, mock.Anything, client.MatchingLabels(labels)).Return(assert.AnError)
+
+		// when
+		_, err := GetPodsForLabels(testCtx, cli, labels)
+
+		// then
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to get pods")
+	})
+}
+
+func TestGetPodForLabelsWithMode(t *testing.T) {
+	var testCtx = context.Background()
+	labels := CesMatchingLabels{DoguLabelName: "ldap", DoguLabelVersion: "1.2.3-4"}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should prefer ready pod during rolling update", func(t *testing.T) {
+		// given
+		oldReady := newPodFinderTestPod("ldap-old", now.Add(-time.Hour), true)
+		newStarting := newPodFinderTestPod("ldap-new", now, false)
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).WithObjects(&oldReady, &newStarting).Build()
+
+		// when
+		actual, err := GetPodForLabelsWithMode(testCtx, cli, labels, PodSelectionPreferReady)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ldap-old", actual.Name)
+	})
+	t.Run("should select newest ready pod", func(t *testing.T) {
+		// given
+		oldReady := newPodFinderTestPod("ldap-old", now.Add(-time.Hour), true)
+		newReady := newPodFinderTestPod("ldap-new", now, true)
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).WithObjects(&oldReady, &newReady).Build()
+
+		// when
+		actual, err := GetPodForLabelsWithMode(testCtx, cli, labels, PodSelectionPreferReady)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ldap-new", actual.Name)
+	})
+	t.Run("should select newest pod if no pod is ready", func(t *testing.T) {
+		// given
+		oldPod := newPodFinderTestPod("ldap-old", now.Add(-time.Hour), false)
+		newPod := newPodFinderTestPod("ldap-new", now, false)
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).WithObjects(&oldPod, &newPod).Build()
+
+		// when
+		actual, err := GetPodForLabelsWithMode(testCtx, cli, labels, PodSelectionPreferReady)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ldap-new", actual.Name)
+	})
+	t.Run("should return not found error for no pods", func(t *testing.T) {
+		// given
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).Build()
+
+		// when
+		_, err := GetPodForLabelsWithMode(testCtx, cli, labels, PodSelectionPreferReady)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, cloudoguerrors.IsNotFoundError(err))
+	})
+	t.Run("should fail for multiple pods with mode exactly one", func(t *testing.T) {
+		// given
+		pod1 := newPodFinderTestPod("ldap-1", now, true)
+		pod2 := newPodFinderTestPod("ldap-2", now, true)
+		cli := fake.NewClientBuilder().WithScheme(getPodFinderTestScheme()).WithObjects(&pod1, &pod2).Build()
+
+		// when
+		_, err := GetPodForLabelsWithMode(testCtx, cli, labels, PodSelectionExactlyOne)
+
+		// then
+		var ambiguousErr *AmbiguousPodError
+		require.ErrorAs(t, err, &ambiguousErr)
 	})
 }
 
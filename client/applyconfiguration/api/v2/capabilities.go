@@ -0,0 +1,43 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	core "github.com/cloudogu/cesapp-lib/core"
+)
+
+// CapabilitiesApplyConfiguration represents a declarative configuration of the Capabilities type for use
+// with apply.
+type CapabilitiesApplyConfiguration struct {
+	Add  []core.Capability `json:"add,omitempty"`
+	Drop []core.Capability `json:"drop,omitempty"`
+}
+
+// CapabilitiesApplyConfiguration constructs a declarative configuration of the Capabilities type for use with
+// apply.
+func Capabilities() *CapabilitiesApplyConfiguration {
+	return &CapabilitiesApplyConfiguration{}
+}
+
+// WithAdd adds the given value to the Add field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Add field.
+func (b *CapabilitiesApplyConfiguration) WithAdd(values ...core.Capability) *CapabilitiesApplyConfiguration {
+	for i := range values {
+		b.Add = append(b.Add, values[i])
+	}
+	return b
+}
+
+// WithDrop adds the given value to the Drop field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drop field.
+func (b *CapabilitiesApplyConfiguration) WithDrop(values ...core.Capability) *CapabilitiesApplyConfiguration {
+	for i := range values {
+		b.Drop = append(b.Drop, values[i])
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+// DataMountApplyConfiguration represents a declarative configuration of the DataMount type for use
+// with apply.
+type DataMountApplyConfiguration struct {
+	SourceType  *apiv2.DataSourceType     `json:"sourceType,omitempty"`
+	Name        *string                   `json:"name,omitempty"`
+	Volume      *string                   `json:"volume,omitempty"`
+	Subfolder   *string                   `json:"subfolder,omitempty"`
+	ReadOnly    *bool                     `json:"readOnly,omitempty"`
+	EmptyDir    *v1.EmptyDirVolumeSource  `json:"emptyDir,omitempty"`
+	Projected   *v1.ProjectedVolumeSource `json:"projected,omitempty"`
+	CSI         *v1.CSIVolumeSource       `json:"csi,omitempty"`
+	Items       []v1.KeyToPath            `json:"items,omitempty"`
+	DefaultMode *int32                    `json:"defaultMode,omitempty"`
+	Optional    *bool                     `json:"optional,omitempty"`
+}
+
+// DataMountApplyConfiguration constructs a declarative configuration of the DataMount type for use with
+// apply.
+func DataMount() *DataMountApplyConfiguration {
+	return &DataMountApplyConfiguration{}
+}
+
+// WithSourceType sets the SourceType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceType field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithSourceType(value apiv2.DataSourceType) *DataMountApplyConfiguration {
+	b.SourceType = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithName(value string) *DataMountApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVolume sets the Volume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Volume field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithVolume(value string) *DataMountApplyConfiguration {
+	b.Volume = &value
+	return b
+}
+
+// WithSubfolder sets the Subfolder field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Subfolder field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithSubfolder(value string) *DataMountApplyConfiguration {
+	b.Subfolder = &value
+	return b
+}
+
+// WithReadOnly sets the ReadOnly field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadOnly field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithReadOnly(value bool) *DataMountApplyConfiguration {
+	b.ReadOnly = &value
+	return b
+}
+
+// WithEmptyDir sets the EmptyDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EmptyDir field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithEmptyDir(value v1.EmptyDirVolumeSource) *DataMountApplyConfiguration {
+	b.EmptyDir = &value
+	return b
+}
+
+// WithProjected sets the Projected field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Projected field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithProjected(value v1.ProjectedVolumeSource) *DataMountApplyConfiguration {
+	b.Projected = &value
+	return b
+}
+
+// WithCSI sets the CSI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CSI field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithCSI(value v1.CSIVolumeSource) *DataMountApplyConfiguration {
+	b.CSI = &value
+	return b
+}
+
+// WithItems adds the given value to the Items field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Items field.
+func (b *DataMountApplyConfiguration) WithItems(values ...v1.KeyToPath) *DataMountApplyConfiguration {
+	for i := range values {
+		b.Items = append(b.Items, values[i])
+	}
+	return b
+}
+
+// WithDefaultMode sets the DefaultMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultMode field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithDefaultMode(value int32) *DataMountApplyConfiguration {
+	b.DefaultMode = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *DataMountApplyConfiguration) WithOptional(value bool) *DataMountApplyConfiguration {
+	b.Optional = &value
+	return b
+}
@@ -0,0 +1,96 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// DoguResourcesApplyConfiguration represents a declarative configuration of the DoguResources type for use
+// with apply.
+type DoguResourcesApplyConfiguration struct {
+	DataVolumeSize      *string                         `json:"dataVolumeSize,omitempty"`
+	MinDataVolumeSize   *resource.Quantity              `json:"minDataVolumeSize,omitempty"`
+	Requests            *v1.ResourceList                `json:"requests,omitempty"`
+	Limits              *v1.ResourceList                `json:"limits,omitempty"`
+	StorageClassName    *string                         `json:"storageClassName,omitempty"`
+	AccessModes         []v1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	VolumeMode          *v1.PersistentVolumeMode        `json:"volumeMode,omitempty"`
+	EphemeralVolumeSize *resource.Quantity              `json:"ephemeralVolumeSize,omitempty"`
+}
+
+// DoguResourcesApplyConfiguration constructs a declarative configuration of the DoguResources type for use with
+// apply.
+func DoguResources() *DoguResourcesApplyConfiguration {
+	return &DoguResourcesApplyConfiguration{}
+}
+
+// WithDataVolumeSize sets the DataVolumeSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataVolumeSize field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithDataVolumeSize(value string) *DoguResourcesApplyConfiguration {
+	b.DataVolumeSize = &value
+	return b
+}
+
+// WithMinDataVolumeSize sets the MinDataVolumeSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinDataVolumeSize field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithMinDataVolumeSize(value resource.Quantity) *DoguResourcesApplyConfiguration {
+	b.MinDataVolumeSize = &value
+	return b
+}
+
+// WithRequests sets the Requests field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Requests field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithRequests(value v1.ResourceList) *DoguResourcesApplyConfiguration {
+	b.Requests = &value
+	return b
+}
+
+// WithLimits sets the Limits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Limits field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithLimits(value v1.ResourceList) *DoguResourcesApplyConfiguration {
+	b.Limits = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithStorageClassName(value string) *DoguResourcesApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithAccessModes adds the given value to the AccessModes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AccessModes field.
+func (b *DoguResourcesApplyConfiguration) WithAccessModes(values ...v1.PersistentVolumeAccessMode) *DoguResourcesApplyConfiguration {
+	for i := range values {
+		b.AccessModes = append(b.AccessModes, values[i])
+	}
+	return b
+}
+
+// WithVolumeMode sets the VolumeMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeMode field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithVolumeMode(value v1.PersistentVolumeMode) *DoguResourcesApplyConfiguration {
+	b.VolumeMode = &value
+	return b
+}
+
+// WithEphemeralVolumeSize sets the EphemeralVolumeSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EphemeralVolumeSize field is set to the value of the last call.
+func (b *DoguResourcesApplyConfiguration) WithEphemeralVolumeSize(value resource.Quantity) *DoguResourcesApplyConfiguration {
+	b.EphemeralVolumeSize = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DoguRestartSpecApplyConfiguration represents a declarative configuration of the DoguRestartSpec type for use
+// with apply.
+type DoguRestartSpecApplyConfiguration struct {
+	DoguName                *string  `json:"doguName,omitempty"`
+	NotBefore               *v1.Time `json:"notBefore,omitempty"`
+	Schedule                *string  `json:"schedule,omitempty"`
+	TTLSecondsAfterFinished *int32   `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// DoguRestartSpecApplyConfiguration constructs a declarative configuration of the DoguRestartSpec type for use with
+// apply.
+func DoguRestartSpec() *DoguRestartSpecApplyConfiguration {
+	return &DoguRestartSpecApplyConfiguration{}
+}
+
+// WithDoguName sets the DoguName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DoguName field is set to the value of the last call.
+func (b *DoguRestartSpecApplyConfiguration) WithDoguName(value string) *DoguRestartSpecApplyConfiguration {
+	b.DoguName = &value
+	return b
+}
+
+// WithNotBefore sets the NotBefore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NotBefore field is set to the value of the last call.
+func (b *DoguRestartSpecApplyConfiguration) WithNotBefore(value v1.Time) *DoguRestartSpecApplyConfiguration {
+	b.NotBefore = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *DoguRestartSpecApplyConfiguration) WithSchedule(value string) *DoguRestartSpecApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithTTLSecondsAfterFinished sets the TTLSecondsAfterFinished field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLSecondsAfterFinished field is set to the value of the last call.
+func (b *DoguRestartSpecApplyConfiguration) WithTTLSecondsAfterFinished(value int32) *DoguRestartSpecApplyConfiguration {
+	b.TTLSecondsAfterFinished = &value
+	return b
+}
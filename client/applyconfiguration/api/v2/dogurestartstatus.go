@@ -0,0 +1,82 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// DoguRestartStatusApplyConfiguration represents a declarative configuration of the DoguRestartStatus type for use
+// with apply.
+type DoguRestartStatusApplyConfiguration struct {
+	Phase              *apiv2.RestartStatusPhase            `json:"phase,omitempty"`
+	StartedAt          *v1.Time                             `json:"startedAt,omitempty"`
+	CompletedAt        *v1.Time                             `json:"completedAt,omitempty"`
+	Message            *string                              `json:"message,omitempty"`
+	ObservedGeneration *int64                               `json:"observedGeneration,omitempty"`
+	Conditions         []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// DoguRestartStatusApplyConfiguration constructs a declarative configuration of the DoguRestartStatus type for use with
+// apply.
+func DoguRestartStatus() *DoguRestartStatusApplyConfiguration {
+	return &DoguRestartStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *DoguRestartStatusApplyConfiguration) WithPhase(value apiv2.RestartStatusPhase) *DoguRestartStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *DoguRestartStatusApplyConfiguration) WithStartedAt(value v1.Time) *DoguRestartStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithCompletedAt sets the CompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedAt field is set to the value of the last call.
+func (b *DoguRestartStatusApplyConfiguration) WithCompletedAt(value v1.Time) *DoguRestartStatusApplyConfiguration {
+	b.CompletedAt = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *DoguRestartStatusApplyConfiguration) WithMessage(value string) *DoguRestartStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *DoguRestartStatusApplyConfiguration) WithObservedGeneration(value int64) *DoguRestartStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *DoguRestartStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *DoguRestartStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,234 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+// DoguSpecApplyConfiguration represents a declarative configuration of the DoguSpec type for use
+// with apply.
+type DoguSpecApplyConfiguration struct {
+	Name                         *string                          `json:"name,omitempty"`
+	Version                      *string                          `json:"version,omitempty"`
+	Resources                    *DoguResourcesApplyConfiguration `json:"resources,omitempty"`
+	Replicas                     *int32                           `json:"replicas,omitempty"`
+	MinReplicas                  *int32                           `json:"minReplicas,omitempty"`
+	MaxReplicas                  *int32                           `json:"maxReplicas,omitempty"`
+	Security                     *SecurityApplyConfiguration      `json:"security,omitempty"`
+	SupportMode                  *bool                            `json:"supportMode,omitempty"`
+	ExportMode                   *bool                            `json:"exportMode,omitempty"`
+	Stopped                      *bool                            `json:"stopped,omitempty"`
+	PauseReconciliation          *bool                            `json:"pauseReconciliation,omitempty"`
+	UpgradeConfig                *UpgradeConfigApplyConfiguration `json:"upgradeConfig,omitempty"`
+	AdditionalIngressAnnotations *apiv2.IngressAnnotations        `json:"additionalIngressAnnotations,omitempty"`
+	AdditionalMounts             []DataMountApplyConfiguration    `json:"additionalMounts,omitempty"`
+	NodeSelector                 map[string]string                `json:"nodeSelector,omitempty"`
+	Tolerations                  []v1.Toleration                  `json:"tolerations,omitempty"`
+	Affinity                     *v1.Affinity                     `json:"affinity,omitempty"`
+	TopologySpreadConstraints    []v1.TopologySpreadConstraint    `json:"topologySpreadConstraints,omitempty"`
+	PriorityClassName            *string                          `json:"priorityClassName,omitempty"`
+	Env                          []v1.EnvVar                      `json:"env,omitempty"`
+	ConfigOverrides              map[string]string                `json:"configOverrides,omitempty"`
+}
+
+// DoguSpecApplyConfiguration constructs a declarative configuration of the DoguSpec type for use with
+// apply.
+func DoguSpec() *DoguSpecApplyConfiguration {
+	return &DoguSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithName(value string) *DoguSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithVersion(value string) *DoguSpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithResources(value *DoguResourcesApplyConfiguration) *DoguSpecApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithReplicas(value int32) *DoguSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithMinReplicas(value int32) *DoguSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithMaxReplicas(value int32) *DoguSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithSecurity sets the Security field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Security field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithSecurity(value *SecurityApplyConfiguration) *DoguSpecApplyConfiguration {
+	b.Security = value
+	return b
+}
+
+// WithSupportMode sets the SupportMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SupportMode field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithSupportMode(value bool) *DoguSpecApplyConfiguration {
+	b.SupportMode = &value
+	return b
+}
+
+// WithExportMode sets the ExportMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExportMode field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithExportMode(value bool) *DoguSpecApplyConfiguration {
+	b.ExportMode = &value
+	return b
+}
+
+// WithStopped sets the Stopped field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stopped field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithStopped(value bool) *DoguSpecApplyConfiguration {
+	b.Stopped = &value
+	return b
+}
+
+// WithPauseReconciliation sets the PauseReconciliation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PauseReconciliation field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithPauseReconciliation(value bool) *DoguSpecApplyConfiguration {
+	b.PauseReconciliation = &value
+	return b
+}
+
+// WithUpgradeConfig sets the UpgradeConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeConfig field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithUpgradeConfig(value *UpgradeConfigApplyConfiguration) *DoguSpecApplyConfiguration {
+	b.UpgradeConfig = value
+	return b
+}
+
+// WithAdditionalIngressAnnotations sets the AdditionalIngressAnnotations field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdditionalIngressAnnotations field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithAdditionalIngressAnnotations(value apiv2.IngressAnnotations) *DoguSpecApplyConfiguration {
+	b.AdditionalIngressAnnotations = &value
+	return b
+}
+
+// WithAdditionalMounts adds the given value to the AdditionalMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalMounts field.
+func (b *DoguSpecApplyConfiguration) WithAdditionalMounts(values ...*DataMountApplyConfiguration) *DoguSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalMounts")
+		}
+		b.AdditionalMounts = append(b.AdditionalMounts, *values[i])
+	}
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *DoguSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *DoguSpecApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *DoguSpecApplyConfiguration) WithTolerations(values ...v1.Toleration) *DoguSpecApplyConfiguration {
+	for i := range values {
+		b.Tolerations = append(b.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithAffinity(value v1.Affinity) *DoguSpecApplyConfiguration {
+	b.Affinity = &value
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *DoguSpecApplyConfiguration) WithTopologySpreadConstraints(values ...v1.TopologySpreadConstraint) *DoguSpecApplyConfiguration {
+	for i := range values {
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *DoguSpecApplyConfiguration) WithPriorityClassName(value string) *DoguSpecApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *DoguSpecApplyConfiguration) WithEnv(values ...v1.EnvVar) *DoguSpecApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithConfigOverrides puts the entries into the ConfigOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ConfigOverrides field,
+// overwriting an existing map entries in ConfigOverrides field with the same key.
+func (b *DoguSpecApplyConfiguration) WithConfigOverrides(entries map[string]string) *DoguSpecApplyConfiguration {
+	if b.ConfigOverrides == nil && len(entries) > 0 {
+		b.ConfigOverrides = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ConfigOverrides[k] = v
+	}
+	return b
+}
@@ -0,0 +1,148 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	time "time"
+
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// DoguStatusApplyConfiguration represents a declarative configuration of the DoguStatus type for use
+// with apply.
+type DoguStatusApplyConfiguration struct {
+	Status           *string                              `json:"status,omitempty"`
+	RequeueTime      *time.Duration                       `json:"requeueTime,omitempty"`
+	RequeuePhase     *string                              `json:"requeuePhase,omitempty"`
+	StartedAt        *v1.Time                             `json:"startedAt,omitempty"`
+	Health           *apiv2.HealthStatus                  `json:"health,omitempty"`
+	InstalledVersion *string                              `json:"installedVersion,omitempty"`
+	Stopped          *bool                                `json:"stopped,omitempty"`
+	ExportMode       *bool                                `json:"exportMode,omitempty"`
+	DataVolumeSize   *resource.Quantity                   `json:"dataVolumeSize,omitempty"`
+	Replicas         *int32                               `json:"replicas,omitempty"`
+	ReadyReplicas    *int32                               `json:"readyReplicas,omitempty"`
+	Selector         *string                              `json:"selector,omitempty"`
+	Conditions       []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// DoguStatusApplyConfiguration constructs a declarative configuration of the DoguStatus type for use with
+// apply.
+func DoguStatus() *DoguStatusApplyConfiguration {
+	return &DoguStatusApplyConfiguration{}
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithStatus(value string) *DoguStatusApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithRequeueTime sets the RequeueTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequeueTime field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithRequeueTime(value time.Duration) *DoguStatusApplyConfiguration {
+	b.RequeueTime = &value
+	return b
+}
+
+// WithRequeuePhase sets the RequeuePhase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequeuePhase field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithRequeuePhase(value string) *DoguStatusApplyConfiguration {
+	b.RequeuePhase = &value
+	return b
+}
+
+// WithStartedAt sets the StartedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartedAt field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithStartedAt(value v1.Time) *DoguStatusApplyConfiguration {
+	b.StartedAt = &value
+	return b
+}
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithHealth(value apiv2.HealthStatus) *DoguStatusApplyConfiguration {
+	b.Health = &value
+	return b
+}
+
+// WithInstalledVersion sets the InstalledVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InstalledVersion field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithInstalledVersion(value string) *DoguStatusApplyConfiguration {
+	b.InstalledVersion = &value
+	return b
+}
+
+// WithStopped sets the Stopped field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stopped field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithStopped(value bool) *DoguStatusApplyConfiguration {
+	b.Stopped = &value
+	return b
+}
+
+// WithExportMode sets the ExportMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExportMode field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithExportMode(value bool) *DoguStatusApplyConfiguration {
+	b.ExportMode = &value
+	return b
+}
+
+// WithDataVolumeSize sets the DataVolumeSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataVolumeSize field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithDataVolumeSize(value resource.Quantity) *DoguStatusApplyConfiguration {
+	b.DataVolumeSize = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithReplicas(value int32) *DoguStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithReadyReplicas(value int32) *DoguStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *DoguStatusApplyConfiguration) WithSelector(value string) *DoguStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *DoguStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *DoguStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
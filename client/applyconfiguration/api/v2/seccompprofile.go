@@ -0,0 +1,39 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+// SeccompProfileApplyConfiguration represents a declarative configuration of the SeccompProfile type for use
+// with apply.
+type SeccompProfileApplyConfiguration struct {
+	Type             *apiv2.SeccompProfileType `json:"type,omitempty"`
+	LocalhostProfile *string                   `json:"localhostProfile,omitempty"`
+}
+
+// SeccompProfileApplyConfiguration constructs a declarative configuration of the SeccompProfile type for use with
+// apply.
+func SeccompProfile() *SeccompProfileApplyConfiguration {
+	return &SeccompProfileApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *SeccompProfileApplyConfiguration) WithType(value apiv2.SeccompProfileType) *SeccompProfileApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithLocalhostProfile sets the LocalhostProfile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalhostProfile field is set to the value of the last call.
+func (b *SeccompProfileApplyConfiguration) WithLocalhostProfile(value string) *SeccompProfileApplyConfiguration {
+	b.LocalhostProfile = &value
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+// SecurityApplyConfiguration represents a declarative configuration of the Security type for use
+// with apply.
+type SecurityApplyConfiguration struct {
+	Capabilities           *CapabilitiesApplyConfiguration    `json:"capabilities,omitempty"`
+	RunAsNonRoot           *bool                              `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFileSystem *bool                              `json:"readOnlyRootFileSystem,omitempty"`
+	SELinuxOptions         *SELinuxOptionsApplyConfiguration  `json:"seLinuxOptions,omitempty"`
+	SeccompProfile         *SeccompProfileApplyConfiguration  `json:"seccompProfile,omitempty"`
+	AppArmorProfile        *AppArmorProfileApplyConfiguration `json:"appArmorProfile,omitempty"`
+}
+
+// SecurityApplyConfiguration constructs a declarative configuration of the Security type for use with
+// apply.
+func Security() *SecurityApplyConfiguration {
+	return &SecurityApplyConfiguration{}
+}
+
+// WithCapabilities sets the Capabilities field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capabilities field is set to the value of the last call.
+func (b *SecurityApplyConfiguration) WithCapabilities(value *CapabilitiesApplyConfiguration) *SecurityApplyConfiguration {
+	b.Capabilities = value
+	return b
+}
+
+// WithRunAsNonRoot sets the RunAsNonRoot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunAsNonRoot field is set to the value of the last call.
+func (b *SecurityApplyConfiguration) WithRunAsNonRoot(value bool) *SecurityApplyConfiguration {
+	b.RunAsNonRoot = &value
+	return b
+}
+
+// WithReadOnlyRootFileSystem sets the ReadOnlyRootFileSystem field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadOnlyRootFileSystem field is set to the value of the last call.
+func (b *SecurityApplyConfiguration) WithReadOnlyRootFileSystem(value bool) *SecurityApplyConfiguration {
+	b.ReadOnlyRootFileSystem = &value
+	return b
+}
+
+// WithSELinuxOptions sets the SELinuxOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SELinuxOptions field is set to the value of the last call.
+func (b *SecurityApplyConfiguration) WithSELinuxOptions(value *SELinuxOptionsApplyConfiguration) *SecurityApplyConfiguration {
+	b.SELinuxOptions = value
+	return b
+}
+
+// WithSeccompProfile sets the SeccompProfile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SeccompProfile field is set to the value of the last call.
+func (b *SecurityApplyConfiguration) WithSeccompProfile(value *SeccompProfileApplyConfiguration) *SecurityApplyConfiguration {
+	b.SeccompProfile = value
+	return b
+}
+
+// WithAppArmorProfile sets the AppArmorProfile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppArmorProfile field is set to the value of the last call.
+func (b *SecurityApplyConfiguration) WithAppArmorProfile(value *AppArmorProfileApplyConfiguration) *SecurityApplyConfiguration {
+	b.AppArmorProfile = value
+	return b
+}
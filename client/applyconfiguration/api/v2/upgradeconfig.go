@@ -0,0 +1,35 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+// UpgradeConfigApplyConfiguration represents a declarative configuration of the UpgradeConfig type for use
+// with apply.
+type UpgradeConfigApplyConfiguration struct {
+	AllowNamespaceSwitch *bool `json:"allowNamespaceSwitch,omitempty"`
+	ForceUpgrade         *bool `json:"forceUpgrade,omitempty"`
+}
+
+// UpgradeConfigApplyConfiguration constructs a declarative configuration of the UpgradeConfig type for use with
+// apply.
+func UpgradeConfig() *UpgradeConfigApplyConfiguration {
+	return &UpgradeConfigApplyConfiguration{}
+}
+
+// WithAllowNamespaceSwitch sets the AllowNamespaceSwitch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowNamespaceSwitch field is set to the value of the last call.
+func (b *UpgradeConfigApplyConfiguration) WithAllowNamespaceSwitch(value bool) *UpgradeConfigApplyConfiguration {
+	b.AllowNamespaceSwitch = &value
+	return b
+}
+
+// WithForceUpgrade sets the ForceUpgrade field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForceUpgrade field is set to the value of the last call.
+func (b *UpgradeConfigApplyConfiguration) WithForceUpgrade(value bool) *UpgradeConfigApplyConfiguration {
+	b.ForceUpgrade = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+This file was generated with "make generate-deepcopy".
+*/
+// Code generated by controller-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
+	internal "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/internal"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=k8s.cloudogu.com, Version=v2
+	case v2.SchemeGroupVersion.WithKind("AppArmorProfile"):
+		return &apiv2.AppArmorProfileApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("Capabilities"):
+		return &apiv2.CapabilitiesApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DataMount"):
+		return &apiv2.DataMountApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("Dogu"):
+		return &apiv2.DoguApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DoguResources"):
+		return &apiv2.DoguResourcesApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DoguRestart"):
+		return &apiv2.DoguRestartApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DoguRestartSpec"):
+		return &apiv2.DoguRestartSpecApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DoguRestartStatus"):
+		return &apiv2.DoguRestartStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DoguSpec"):
+		return &apiv2.DoguSpecApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("DoguStatus"):
+		return &apiv2.DoguStatusApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("SeccompProfile"):
+		return &apiv2.SeccompProfileApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("Security"):
+		return &apiv2.SecurityApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("SELinuxOptions"):
+		return &apiv2.SELinuxOptionsApplyConfiguration{}
+	case v2.SchemeGroupVersion.WithKind("UpgradeConfig"):
+		return &apiv2.UpgradeConfigApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) managedfields.TypeConverter {
+	return managedfields.NewSchemeTypeConverter(scheme, internal.Parser())
+}
@@ -3,8 +3,11 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
@@ -12,6 +15,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
 	"github.com/cloudogu/retry-lib/retry"
 )
 
@@ -27,6 +31,10 @@ type DoguInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*v2.DoguList, error)
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v2.Dogu, err error)
+	Apply(ctx context.Context, dogu *acv2.DoguApplyConfiguration, opts metav1.ApplyOptions) (result *v2.Dogu, err error)
+	ApplyStatus(ctx context.Context, dogu *acv2.DoguApplyConfiguration, opts metav1.ApplyOptions) (result *v2.Dogu, err error)
+	GetScale(ctx context.Context, doguName string, opts metav1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, doguName string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error)
 }
 
 type doguClient struct {
@@ -44,7 +52,7 @@ func (d *doguClient) Get(ctx context.Context, name string, options metav1.GetOpt
 		VersionedParams(&options, scheme.ParameterCodec).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguError(name, err)
 }
 
 // List takes label and field selectors, and returns the list of Dogus that match those selectors.
@@ -89,7 +97,7 @@ func (d *doguClient) Create(ctx context.Context, dogu *v2.Dogu, opts metav1.Crea
 		Body(dogu).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toResourceError(doguResource, dogu.Name, err)
 }
 
 // Update takes the representation of a dogu and updates it. Returns the server's representation of the dogu, and an error, if there is any.
@@ -103,7 +111,7 @@ func (d *doguClient) Update(ctx context.Context, dogu *v2.Dogu, opts metav1.Upda
 		Body(dogu).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguError(dogu.Name, err)
 }
 
 // UpdateSpecWithRetry updates the spec of the resource, retrying if a conflict error arises.
@@ -146,7 +154,7 @@ func (d *doguClient) UpdateStatus(ctx context.Context, dogu *v2.Dogu, opts metav
 		Body(dogu).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguError(dogu.Name, err)
 }
 
 // UpdateStatusWithRetry updates the status of the resource, retrying if a conflict error arises.
@@ -178,13 +186,14 @@ func (d *doguClient) UpdateStatusWithRetry(ctx context.Context, dogu *v2.Dogu, m
 
 // Delete takes name of the dogu and deletes it. Returns an error if one occurs.
 func (d *doguClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return d.client.Delete().
+	err := d.client.Delete().
 		Namespace(d.ns).
 		Resource("dogus").
 		Name(name).
 		Body(&opts).
 		Do(ctx).
 		Error()
+	return toDoguError(name, err)
 }
 
 // DeleteCollection deletes a collection of objects.
@@ -215,5 +224,74 @@ func (d *doguClient) Patch(ctx context.Context, name string, pt types.PatchType,
 		Body(data).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguError(name, err)
+}
+
+// GetScale takes name of the dogu, and returns the corresponding scale object of the dogu's scale subresource.
+func (d *doguClient) GetScale(ctx context.Context, doguName string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	result := &autoscalingv1.Scale{}
+	err := d.client.Get().
+		Namespace(d.ns).
+		Resource("dogus").
+		Name(doguName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, toDoguError(doguName, err)
+}
+
+// UpdateScale takes the representation of a scale and updates the dogu's scale subresource. Returns the server's
+// representation of the scale, and an error, if there is any.
+func (d *doguClient) UpdateScale(ctx context.Context, doguName string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	result := &autoscalingv1.Scale{}
+	err := d.client.Put().
+		Namespace(d.ns).
+		Resource("dogus").
+		Name(doguName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return result, toDoguError(doguName, err)
+}
+
+// Apply takes the given apply declarative configuration, applies it with server-side apply and returns the applied dogu.
+// The field manager in opts identifies the owner of the applied fields and must be set.
+func (d *doguClient) Apply(ctx context.Context, dogu *acv2.DoguApplyConfiguration, opts metav1.ApplyOptions) (result *v2.Dogu, err error) {
+	return d.apply(ctx, dogu, opts)
+}
+
+// ApplyStatus takes the given apply declarative configuration, applies it to the status subresource with server-side
+// apply and returns the applied dogu. The field manager in opts identifies the owner of the applied fields and must be set.
+func (d *doguClient) ApplyStatus(ctx context.Context, dogu *acv2.DoguApplyConfiguration, opts metav1.ApplyOptions) (result *v2.Dogu, err error) {
+	return d.apply(ctx, dogu, opts, "status")
+}
+
+func (d *doguClient) apply(ctx context.Context, dogu *acv2.DoguApplyConfiguration, opts metav1.ApplyOptions, subresources ...string) (result *v2.Dogu, err error) {
+	if dogu == nil {
+		return nil, fmt.Errorf("dogu provided to apply must not be nil")
+	}
+	if dogu.GetName() == nil {
+		return nil, fmt.Errorf("dogu name must be provided to apply")
+	}
+
+	data, err := json.Marshal(dogu)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dogu apply configuration: %w", err)
+	}
+
+	patchOpts := opts.ToPatchOptions()
+	result = &v2.Dogu{}
+	err = d.client.Patch(types.ApplyPatchType).
+		Namespace(d.ns).
+		Resource("dogus").
+		Name(*dogu.GetName()).
+		SubResource(subresources...).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return result, toResourceError(doguResource, *dogu.GetName(), err)
 }
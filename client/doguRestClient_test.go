@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	k8sv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"io"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -47,6 +49,34 @@ func Test_doguClient_Get(t *testing.T) {
 		// then
 		require.NoError(t, err)
 	})
+	t.Run("should return DoguNotFoundError", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.Header().Add("content-type", "application/json")
+			writer.WriteHeader(http.StatusNotFound)
+			status := errors.NewNotFound(schema.GroupResource{Group: "k8s.cloudogu.com", Resource: "dogus"}, "testdogu").Status()
+			statusBytes, err := json.Marshal(&status)
+			require.NoError(t, err)
+			_, err = writer.Write(statusBytes)
+			require.NoError(t, err)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.Dogus("test")
+
+		// when
+		_, err = dClient.Get(context.TODO(), "testdogu", v1.GetOptions{})
+
+		// then
+		var notFoundErr *DoguNotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		assert.Equal(t, "testdogu", notFoundErr.DoguName)
+		assert.True(t, errors.IsNotFound(err))
+	})
 }
 
 func Test_doguClient_List(t *testing.T) {
@@ -118,6 +148,35 @@ func Test_doguClient_Create(t *testing.T) {
 		// then
 		require.NoError(t, err)
 	})
+	t.Run("should not return DoguNotFoundError for missing namespace", func(t *testing.T) {
+		// given
+		dogu := &k8sv2.Dogu{ObjectMeta: v1.ObjectMeta{GenerateName: "tocreate-", Namespace: "test"}}
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.Header().Add("content-type", "application/json")
+			writer.WriteHeader(http.StatusNotFound)
+			status := errors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "test").Status()
+			statusBytes, err := json.Marshal(&status)
+			require.NoError(t, err)
+			_, err = writer.Write(statusBytes)
+			require.NoError(t, err)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.Dogus("test")
+
+		// when
+		_, err = dClient.Create(context.TODO(), dogu, v1.CreateOptions{})
+
+		// then
+		var notFoundErr *DoguNotFoundError
+		assert.NotErrorAs(t, err, &notFoundErr)
+		assert.True(t, errors.IsNotFound(err))
+	})
 }
 
 func Test_doguClient_Update(t *testing.T) {
@@ -157,6 +216,83 @@ func Test_doguClient_Update(t *testing.T) {
 	})
 }
 
+func Test_doguClient_GetScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodGet, request.Method)
+			assert.Equal(t, "/apis/k8s.cloudogu.com/v2/namespaces/test/dogus/testdogu/scale", request.URL.Path)
+
+			writer.Header().Add("content-type", "application/json")
+			scale := &autoscalingv1.Scale{
+				ObjectMeta: v1.ObjectMeta{Name: "testdogu", Namespace: "test"},
+				Spec:       autoscalingv1.ScaleSpec{Replicas: 2},
+				Status:     autoscalingv1.ScaleStatus{Replicas: 1, Selector: "dogu.name=testdogu"},
+			}
+			scaleBytes, err := json.Marshal(scale)
+			require.NoError(t, err)
+			_, err = writer.Write(scaleBytes)
+			require.NoError(t, err)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.Dogus("test")
+
+		// when
+		actual, err := dClient.GetScale(context.TODO(), "testdogu", v1.GetOptions{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), actual.Spec.Replicas)
+		assert.Equal(t, int32(1), actual.Status.Replicas)
+		assert.Equal(t, "dogu.name=testdogu", actual.Status.Selector)
+	})
+}
+
+func Test_doguClient_UpdateScale(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		scale := &autoscalingv1.Scale{
+			ObjectMeta: v1.ObjectMeta{Name: "testdogu", Namespace: "test"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodPut, request.Method)
+			assert.Equal(t, "/apis/k8s.cloudogu.com/v2/namespaces/test/dogus/testdogu/scale", request.URL.Path)
+
+			bytes, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+
+			updatedScale := &autoscalingv1.Scale{}
+			require.NoError(t, json.Unmarshal(bytes, updatedScale))
+			assert.Equal(t, int32(3), updatedScale.Spec.Replicas)
+
+			writer.Header().Add("content-type", "application/json")
+			_, err = writer.Write(bytes)
+			require.NoError(t, err)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.Dogus("test")
+
+		// when
+		actual, err := dClient.UpdateScale(context.TODO(), "testdogu", scale, v1.UpdateOptions{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), actual.Spec.Replicas)
+	})
+}
+
 func Test_doguClient_UpdateStatus(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// given
@@ -484,3 +620,94 @@ func Test_doguClient_UpdateStatusWithRetry(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func Test_doguClient_Apply(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodPatch, request.Method)
+			assert.Equal(t, "/apis/k8s.cloudogu.com/v2/namespaces/test/dogus/testdogu", request.URL.Path)
+			assert.Equal(t, string(types.ApplyPatchType), request.Header.Get("Content-Type"))
+			assert.Equal(t, "fieldManager=test-manager&force=true", request.URL.RawQuery)
+			bytes, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"kind":"Dogu","apiVersion":"k8s.cloudogu.com/v2","metadata":{"name":"testdogu","namespace":"test"},"spec":{"stopped":true}}`, string(bytes))
+			result, err := json.Marshal(k8sv2.Dogu{})
+			require.NoError(t, err)
+
+			writer.Header().Add("content-type", "application/json")
+			_, err = writer.Write(result)
+			require.NoError(t, err)
+			writer.WriteHeader(200)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.Dogus("test")
+
+		// when
+		_, err = dClient.Apply(context.TODO(), acv2.Dogu("testdogu", "test").WithSpec(acv2.DoguSpec().WithStopped(true)), v1.ApplyOptions{FieldManager: "test-manager", Force: true})
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail for nil apply configuration", func(t *testing.T) {
+		// given
+		client, err := NewForConfig(&rest.Config{})
+		require.NoError(t, err)
+
+		// when
+		_, err = client.Dogus("test").Apply(context.TODO(), nil, v1.ApplyOptions{FieldManager: "test-manager"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "dogu provided to apply must not be nil")
+	})
+	t.Run("should fail for missing name", func(t *testing.T) {
+		// given
+		client, err := NewForConfig(&rest.Config{})
+		require.NoError(t, err)
+
+		// when
+		_, err = client.Dogus("test").Apply(context.TODO(), &acv2.DoguApplyConfiguration{}, v1.ApplyOptions{FieldManager: "test-manager"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "dogu name must be provided to apply")
+	})
+}
+
+func Test_doguClient_ApplyStatus(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodPatch, request.Method)
+			assert.Equal(t, "/apis/k8s.cloudogu.com/v2/namespaces/test/dogus/testdogu/status", request.URL.Path)
+			assert.Equal(t, string(types.ApplyPatchType), request.Header.Get("Content-Type"))
+			assert.Equal(t, "fieldManager=test-manager&force=false", request.URL.RawQuery)
+			result, err := json.Marshal(k8sv2.Dogu{})
+			require.NoError(t, err)
+
+			writer.Header().Add("content-type", "application/json")
+			_, err = writer.Write(result)
+			require.NoError(t, err)
+			writer.WriteHeader(200)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.Dogus("test")
+
+		// when
+		_, err = dClient.ApplyStatus(context.TODO(), acv2.Dogu("testdogu", "test").WithStatus(acv2.DoguStatus().WithInstalledVersion("1.2.3-4")), v1.ApplyOptions{FieldManager: "test-manager"})
+
+		// then
+		require.NoError(t, err)
+	})
+}
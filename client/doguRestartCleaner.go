@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+// CleanupOptions configure which finished dogu restarts a DoguRestartCleaner deletes.
+type CleanupOptions struct {
+	// KeepLastPerDogu is the number of most recently finished dogu restarts per dogu that are kept even if
+	// their TTL expired. A zero value deletes all expired dogu restarts.
+	KeepLastPerDogu int
+}
+
+// DoguRestartCleaner deletes finished dogu restarts after their TTLSecondsAfterFinished expired.
+type DoguRestartCleaner struct {
+	restarts DoguRestartInterface
+	now      func() time.Time
+}
+
+// NewDoguRestartCleaner creates a DoguRestartCleaner which deletes dogu restarts with the given client.
+func NewDoguRestartCleaner(restarts DoguRestartInterface) *DoguRestartCleaner {
+	return &DoguRestartCleaner{restarts: restarts, now: time.Now}
+}
+
+// Cleanup lists all dogu restarts and deletes the expired ones. Dogu restarts which are not finished or have no
+// TTL are never deleted. It returns the names of the deleted dogu restarts. If deleting some dogu restarts fails,
+// the other ones are deleted anyway and all errors are returned.
+func (c *DoguRestartCleaner) Cleanup(ctx context.Context, opts CleanupOptions) ([]string, error) {
+	list, err := c.restarts.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dogu restarts: %w", err)
+	}
+
+	var deleted []string
+	var errs []error
+	for _, restart := range c.findExpired(list.Items, opts) {
+		err = c.restarts.Delete(ctx, restart.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &restart.UID},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete dogu restart %s: %w", restart.Name, err))
+			continue
+		}
+		deleted = append(deleted, restart.Name)
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+func (c *DoguRestartCleaner) findExpired(restarts []v2.DoguRestart, opts CleanupOptions) []v2.DoguRestart {
+	finishedByDogu := map[string][]v2.DoguRestart{}
+	for _, restart := range restarts {
+		if _, finished := restart.FinishedAt(); finished {
+			finishedByDogu[restart.Spec.DoguName] = append(finishedByDogu[restart.Spec.DoguName], restart)
+		}
+	}
+
+	now := c.now()
+	var expired []v2.DoguRestart
+	for _, finished := range finishedByDogu {
+		// newest first, so that the most recent restarts are kept
+		slices.SortFunc(finished, func(a, b v2.DoguRestart) int {
+			aFinishedAt, _ := a.FinishedAt()
+			bFinishedAt, _ := b.FinishedAt()
+			return bFinishedAt.Compare(aFinishedAt)
+		})
+
+		for i, restart := range finished {
+			if i >= opts.KeepLastPerDogu && restart.IsExpired(now) {
+				expired = append(expired, restart)
+			}
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	k8sv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+var cleanerTestNow = time.Date(2025, 11, 15, 12, 0, 0, 0, time.UTC)
+
+func newCleanerTestRestart(name string, doguName string, phase k8sv2.RestartStatusPhase, finishedAgo time.Duration, ttlSeconds *int32) k8sv2.DoguRestart {
+	completedAt := metav1.NewTime(cleanerTestNow.Add(-finishedAgo))
+	return k8sv2.DoguRestart{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-uid")},
+		Spec:       k8sv2.DoguRestartSpec{DoguName: doguName, TTLSecondsAfterFinished: ttlSeconds},
+		Status:     k8sv2.DoguRestartStatus{Phase: phase, CompletedAt: &completedAt},
+	}
+}
+
+func withUID(uid string) interface{} {
+	return mock.MatchedBy(func(opts metav1.DeleteOptions) bool {
+		return opts.Preconditions != nil && string(*opts.Preconditions.UID) == uid
+	})
+}
+
+func TestDoguRestartCleaner_Cleanup(t *testing.T) {
+	ttl := int32(3600)
+	restarts := []k8sv2.DoguRestart{
+		newCleanerTestRestart("ldap-restart-1", "ldap", k8sv2.RestartStatusPhaseCompleted, 3*time.Hour, &ttl),
+		newCleanerTestRestart("ldap-restart-2", "ldap", k8sv2.RestartStatusPhaseFailedStart, 2*time.Hour, &ttl),
+		newCleanerTestRestart("ldap-restart-3", "ldap", k8sv2.RestartStatusPhaseCompleted, 30*time.Minute, &ttl),
+		newCleanerTestRestart("ldap-restart-4", "ldap", k8sv2.RestartStatusPhaseStarting, 0, &ttl),
+		newCleanerTestRestart("cas-restart-1", "cas", k8sv2.RestartStatusPhaseCompleted, 5*time.Hour, &ttl),
+		newCleanerTestRestart("cas-restart-2", "cas", k8sv2.RestartStatusPhaseCompleted, 5*time.Hour, nil),
+	}
+
+	t.Run("should delete all expired restarts", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		restartMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&k8sv2.DoguRestartList{Items: restarts}, nil)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-1", withUID("ldap-restart-1-uid")).Return(nil)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-2", withUID("ldap-restart-2-uid")).Return(nil)
+		restartMock.EXPECT().Delete(mock.Anything, "cas-restart-1", withUID("cas-restart-1-uid")).Return(nil)
+		sut := NewDoguRestartCleaner(restartMock)
+		sut.now = func() time.Time { return cleanerTestNow }
+
+		// when
+		deleted, err := sut.Cleanup(context.TODO(), CleanupOptions{})
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"ldap-restart-1", "ldap-restart-2", "cas-restart-1"}, deleted)
+	})
+	t.Run("should keep last finished restarts per dogu", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		restartMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&k8sv2.DoguRestartList{Items: restarts}, nil)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-1", withUID("ldap-restart-1-uid")).Return(nil)
+		sut := NewDoguRestartCleaner(restartMock)
+		sut.now = func() time.Time { return cleanerTestNow }
+
+		// when
+		deleted, err := sut.Cleanup(context.TODO(), CleanupOptions{KeepLastPerDogu: 2})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ldap-restart-1"}, deleted)
+	})
+	t.Run("should ignore already deleted restarts and continue on errors", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		restartMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(&k8sv2.DoguRestartList{Items: restarts}, nil)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-1", mock.Anything).Return(assert.AnError)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-2", mock.Anything).
+			Return(apierrors.NewNotFound(schema.GroupResource{Group: "k8s.cloudogu.com", Resource: "dogurestarts"}, "ldap-restart-2"))
+		restartMock.EXPECT().Delete(mock.Anything, "cas-restart-1", mock.Anything).Return(nil)
+		sut := NewDoguRestartCleaner(restartMock)
+		sut.now = func() time.Time { return cleanerTestNow }
+
+		// when
+		deleted, err := sut.Cleanup(context.TODO(), CleanupOptions{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to delete dogu restart ldap-restart-1")
+		assert.ElementsMatch(t, []string{"ldap-restart-2", "cas-restart-1"}, deleted)
+	})
+	t.Run("should return error if listing fails", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		restartMock.EXPECT().List(mock.Anything, metav1.ListOptions{}).Return(nil, assert.AnError)
+		sut := NewDoguRestartCleaner(restartMock)
+
+		// when
+		_, err := sut.Cleanup(context.TODO(), CleanupOptions{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to list dogu restarts")
+	})
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/cloudogu/cesapp-lib/core"
+)
+
+// OrderDogusForRestart groups the dogus with the given names into batches based on the dogu dependencies in the
+// given descriptors. Every dogu is placed in a batch after all batches containing its dependencies, so that the
+// dogus of a batch can be restarted in parallel once the previous batches have been restarted.
+//
+// If includeDependents is true, all dogus of the descriptors which directly or transitively depend on one of the
+// given dogus are restarted as well. Only dependencies between restarted dogus affect the order.
+// An error is returned if a descriptor is missing or the dependencies contain a cycle.
+func OrderDogusForRestart(doguNames []string, descriptors []*core.Dogu, includeDependents bool) ([][]string, error) {
+	dependencies := make(map[string][]string, len(descriptors))
+	for _, descriptor := range descriptors {
+		for _, dependency := range descriptor.GetAllDependenciesOfType(core.DependencyTypeDogu) {
+			dependencies[descriptor.GetSimpleName()] = append(dependencies[descriptor.GetSimpleName()], dependency.Name)
+		}
+	}
+
+	restarted := make(map[string]bool, len(doguNames))
+	for _, name := range doguNames {
+		if !slices.ContainsFunc(descriptors, func(descriptor *core.Dogu) bool { return descriptor.GetSimpleName() == name }) {
+			return nil, fmt.Errorf("missing descriptor for dogu %s", name)
+		}
+		restarted[name] = true
+	}
+
+	if includeDependents {
+		addDependents(restarted, dependencies)
+	}
+
+	return batchByDependencies(restarted, dependencies)
+}
+
+func addDependents(restarted map[string]bool, dependencies map[string][]string) {
+	for added := true; added; {
+		added = false
+		for dogu, doguDependencies := range dependencies {
+			if restarted[dogu] {
+				continue
+			}
+			if slices.ContainsFunc(doguDependencies, func(dependency string) bool { return restarted[dependency] }) {
+				restarted[dogu] = true
+				added = true
+			}
+		}
+	}
+}
+
+func batchByDependencies(restarted map[string]bool, dependencies map[string][]string) ([][]string, error) {
+	var batches [][]string
+	done := make(map[string]bool, len(restarted))
+	for len(done) < len(restarted) {
+		var batch []string
+		for dogu := range restarted {
+			if done[dogu] {
+				continue
+			}
+			if !slices.ContainsFunc(dependencies[dogu], func(dependency string) bool { return restarted[dependency] && !done[dependency] }) {
+				batch = append(batch, dogu)
+			}
+		}
+
+		if len(batch) == 0 {
+			return nil, fmt.Errorf("failed to order dogus for restart: dependencies contain a cycle")
+		}
+
+		slices.Sort(batch)
+		for _, dogu := range batch {
+			done[dogu] = true
+		}
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrderTestDescriptor(name string, dependencies ...string) *core.Dogu {
+	descriptor := &core.Dogu{Name: "official/" + name}
+	for _, dependency := range dependencies {
+		descriptor.Dependencies = append(descriptor.Dependencies, core.Dependency{Type: core.DependencyTypeDogu, Name: dependency})
+	}
+	return descriptor
+}
+
+func TestOrderDogusForRestart(t *testing.T) {
+	descriptors := []*core.Dogu{
+		newOrderTestDescriptor("postgresql"),
+		newOrderTestDescriptor("ldap"),
+		newOrderTestDescriptor("cas", "ldap"),
+		newOrderTestDescriptor("redmine", "postgresql", "cas"),
+		newOrderTestDescriptor("scm", "cas"),
+		newOrderTestDescriptor("nginx"),
+	}
+
+	t.Run("should order dogus by their dependencies", func(t *testing.T) {
+		// when
+		actual, err := OrderDogusForRestart([]string{"redmine", "cas", "postgresql", "nginx"}, descriptors, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"cas", "nginx", "postgresql"}, {"redmine"}}, actual)
+	})
+	t.Run("should include transitive dependents", func(t *testing.T) {
+		// when
+		actual, err := OrderDogusForRestart([]string{"ldap"}, descriptors, true)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"ldap"}, {"cas"}, {"redmine", "scm"}}, actual)
+	})
+	t.Run("should include dependents of a dogu without dependencies", func(t *testing.T) {
+		// when
+		actual, err := OrderDogusForRestart([]string{"postgresql"}, descriptors, true)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"postgresql"}, {"redmine"}}, actual)
+	})
+	t.Run("should return error for missing descriptor", func(t *testing.T) {
+		// when
+		_, err := OrderDogusForRestart([]string{"jenkins"}, descriptors, false)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "missing descriptor for dogu jenkins")
+	})
+	t.Run("should return error for cyclic dependencies", func(t *testing.T) {
+		// given
+		cyclic := []*core.Dogu{newOrderTestDescriptor("a", "b"), newOrderTestDescriptor("b", "a")}
+
+		// when
+		_, err := OrderDogusForRestart([]string{"a", "b"}, cyclic, false)
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "dependencies contain a cycle")
+	})
+}
@@ -3,6 +3,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
 	"github.com/cloudogu/retry-lib/retry"
 )
 
@@ -27,6 +30,8 @@ type DoguRestartInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*v2.DoguRestartList, error)
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v2.DoguRestart, err error)
+	Apply(ctx context.Context, doguRestart *acv2.DoguRestartApplyConfiguration, opts metav1.ApplyOptions) (result *v2.DoguRestart, err error)
+	ApplyStatus(ctx context.Context, doguRestart *acv2.DoguRestartApplyConfiguration, opts metav1.ApplyOptions) (result *v2.DoguRestart, err error)
 }
 
 type doguRestartClient struct {
@@ -44,7 +49,7 @@ func (d *doguRestartClient) Get(ctx context.Context, name string, options metav1
 		VersionedParams(&options, scheme.ParameterCodec).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguRestartError(name, err)
 }
 
 // List takes label and field selectors, and returns the list of dogu restarts that match those selectors.
@@ -89,7 +94,7 @@ func (d *doguRestartClient) Create(ctx context.Context, dogu *v2.DoguRestart, op
 		Body(dogu).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguRestartError(dogu.Name, err)
 }
 
 // Update takes the representation of a dogu restart and updates it. Returns the server's representation of the dogu restart, and an error, if there is any.
@@ -103,7 +108,7 @@ func (d *doguRestartClient) Update(ctx context.Context, dogu *v2.DoguRestart, op
 		Body(dogu).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguRestartError(dogu.Name, err)
 }
 
 // UpdateSpecWithRetry updates the spec of the resource, retrying if a conflict error arises.
@@ -145,7 +150,7 @@ func (d *doguRestartClient) UpdateStatus(ctx context.Context, dogu *v2.DoguResta
 		Body(dogu).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguRestartError(dogu.Name, err)
 }
 
 // UpdateStatusWithRetry updates the status of the resource, retrying if a conflict error arises.
@@ -177,13 +182,14 @@ func (d *doguRestartClient) UpdateStatusWithRetry(ctx context.Context, doguResta
 
 // Delete takes name of the dogu restart and deletes it. Returns an error if one occurs.
 func (d *doguRestartClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return d.client.Delete().
+	err := d.client.Delete().
 		Namespace(d.ns).
 		Resource("dogurestarts").
 		Name(name).
 		Body(&opts).
 		Do(ctx).
 		Error()
+	return toDoguRestartError(name, err)
 }
 
 // DeleteCollection deletes a collection of objects.
@@ -214,5 +220,44 @@ func (d *doguRestartClient) Patch(ctx context.Context, name string, pt types.Pat
 		Body(data).
 		Do(ctx).
 		Into(result)
-	return
+	return result, toDoguRestartError(name, err)
+}
+
+// Apply takes the given apply declarative configuration, applies it with server-side apply and returns the applied dogu restart.
+// The field manager in opts identifies the owner of the applied fields and must be set.
+func (d *doguRestartClient) Apply(ctx context.Context, doguRestart *acv2.DoguRestartApplyConfiguration, opts metav1.ApplyOptions) (result *v2.DoguRestart, err error) {
+	return d.apply(ctx, doguRestart, opts)
+}
+
+// ApplyStatus takes the given apply declarative configuration, applies it to the status subresource with server-side
+// apply and returns the applied dogu restart. The field manager in opts identifies the owner of the applied fields and must be set.
+func (d *doguRestartClient) ApplyStatus(ctx context.Context, doguRestart *acv2.DoguRestartApplyConfiguration, opts metav1.ApplyOptions) (result *v2.DoguRestart, err error) {
+	return d.apply(ctx, doguRestart, opts, "status")
+}
+
+func (d *doguRestartClient) apply(ctx context.Context, doguRestart *acv2.DoguRestartApplyConfiguration, opts metav1.ApplyOptions, subresources ...string) (result *v2.DoguRestart, err error) {
+	if doguRestart == nil {
+		return nil, fmt.Errorf("dogu restart provided to apply must not be nil")
+	}
+	if doguRestart.GetName() == nil {
+		return nil, fmt.Errorf("dogu restart name must be provided to apply")
+	}
+
+	data, err := json.Marshal(doguRestart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dogu restart apply configuration: %w", err)
+	}
+
+	patchOpts := opts.ToPatchOptions()
+	result = &v2.DoguRestart{}
+	err = d.client.Patch(types.ApplyPatchType).
+		Namespace(d.ns).
+		Resource("dogurestarts").
+		Name(*doguRestart.GetName()).
+		SubResource(subresources...).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return result, toDoguRestartError(*doguRestart.GetName(), err)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	k8sv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"io"
@@ -484,3 +485,94 @@ func Test_doguRestartClient_UpdateStatusWithRetry(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func Test_doguRestartClient_Apply(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodPatch, request.Method)
+			assert.Equal(t, "/apis/k8s.cloudogu.com/v2/namespaces/test/dogurestarts/testdogu-restart", request.URL.Path)
+			assert.Equal(t, string(types.ApplyPatchType), request.Header.Get("Content-Type"))
+			assert.Equal(t, "fieldManager=test-manager&force=true", request.URL.RawQuery)
+			bytes, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"kind":"DoguRestart","apiVersion":"k8s.cloudogu.com/v2","metadata":{"name":"testdogu-restart","namespace":"test"},"spec":{"doguName":"testdogu"}}`, string(bytes))
+			result, err := json.Marshal(k8sv2.DoguRestart{})
+			require.NoError(t, err)
+
+			writer.Header().Add("content-type", "application/json")
+			_, err = writer.Write(result)
+			require.NoError(t, err)
+			writer.WriteHeader(200)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.DoguRestarts("test")
+
+		// when
+		_, err = dClient.Apply(context.TODO(), acv2.DoguRestart("testdogu-restart", "test").WithSpec(acv2.DoguRestartSpec().WithDoguName("testdogu")), v1.ApplyOptions{FieldManager: "test-manager", Force: true})
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should fail for nil apply configuration", func(t *testing.T) {
+		// given
+		client, err := NewForConfig(&rest.Config{})
+		require.NoError(t, err)
+
+		// when
+		_, err = client.DoguRestarts("test").Apply(context.TODO(), nil, v1.ApplyOptions{FieldManager: "test-manager"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "dogu restart provided to apply must not be nil")
+	})
+	t.Run("should fail for missing name", func(t *testing.T) {
+		// given
+		client, err := NewForConfig(&rest.Config{})
+		require.NoError(t, err)
+
+		// when
+		_, err = client.DoguRestarts("test").Apply(context.TODO(), &acv2.DoguRestartApplyConfiguration{}, v1.ApplyOptions{FieldManager: "test-manager"})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "dogu restart name must be provided to apply")
+	})
+}
+
+func Test_doguRestartClient_ApplyStatus(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodPatch, request.Method)
+			assert.Equal(t, "/apis/k8s.cloudogu.com/v2/namespaces/test/dogurestarts/testdogu-restart/status", request.URL.Path)
+			assert.Equal(t, string(types.ApplyPatchType), request.Header.Get("Content-Type"))
+			assert.Equal(t, "fieldManager=test-manager&force=false", request.URL.RawQuery)
+			result, err := json.Marshal(k8sv2.DoguRestart{})
+			require.NoError(t, err)
+
+			writer.Header().Add("content-type", "application/json")
+			_, err = writer.Write(result)
+			require.NoError(t, err)
+			writer.WriteHeader(200)
+		}))
+
+		config := rest.Config{
+			Host: server.URL,
+		}
+		client, err := NewForConfig(&config)
+		require.NoError(t, err)
+		dClient := client.DoguRestarts("test")
+
+		// when
+		_, err = dClient.ApplyStatus(context.TODO(), acv2.DoguRestart("testdogu-restart", "test").WithStatus(acv2.DoguRestartStatus().WithPhase(k8sv2.RestartStatusPhaseStopping)), v1.ApplyOptions{FieldManager: "test-manager"})
+
+		// then
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+// RestartOptions configure how a DoguRestarter restarts a dogu.
+type RestartOptions struct {
+	// Timeout is the maximum duration to wait for the restart. A zero value waits until the given context is done.
+	Timeout time.Duration
+	// OnProgress is called every time the restart reaches a new phase. It may be nil.
+	OnProgress func(phase v2.RestartStatusPhase)
+	// DeleteAfterFinished deletes the dogu restart resource after the restart completed or failed.
+	DeleteAfterFinished bool
+}
+
+// DoguRestarter restarts dogus by creating dogu restart resources and waiting for them to finish.
+type DoguRestarter struct {
+	restarts DoguRestartInterface
+}
+
+// NewDoguRestarter creates a DoguRestarter which manages dogu restarts with the given client.
+func NewDoguRestarter(restarts DoguRestartInterface) *DoguRestarter {
+	return &DoguRestarter{restarts: restarts}
+}
+
+// RestartDogu creates a dogu restart resource for the dogu with the given name and blocks until the restart completed.
+//
+// If the restart ends in a failure phase, a *DoguRestartError is returned. The last observed dogu restart
+// is returned in any case once it was created.
+func (r *DoguRestarter) RestartDogu(ctx context.Context, doguName string, opts RestartOptions) (*v2.DoguRestart, error) {
+	restart := &v2.DoguRestart{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: doguName + "-restart-"},
+		Spec:       v2.DoguRestartSpec{DoguName: doguName},
+	}
+	created, err := r.restarts.Create(ctx, restart, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create restart for dogu %s: %w", doguName, err)
+	}
+
+	lastObserved, err := r.waitForRestart(ctx, created, opts)
+	if opts.DeleteAfterFinished {
+		// delete the restart even if the given context is already done
+		deleteErr := r.restarts.Delete(context.WithoutCancel(ctx), created.Name, metav1.DeleteOptions{})
+		if deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to delete restart %s of dogu %s: %w", created.Name, doguName, deleteErr))
+		}
+	}
+
+	return lastObserved, err
+}
+
+// BulkRestartOptions configure how a DoguRestarter restarts multiple dogus.
+type BulkRestartOptions struct {
+	// Timeout is the maximum duration to wait for the restart of a single dogu.
+	// A zero value waits until the given context is done.
+	Timeout time.Duration
+	// OnProgress is called every time the restart of a dogu reaches a new phase. It may be nil.
+	// If Parallel is true, it is called concurrently.
+	OnProgress func(doguName string, phase v2.RestartStatusPhase)
+	// DeleteAfterFinished deletes the dogu restart resources after the restarts completed or failed.
+	DeleteAfterFinished bool
+	// Parallel restarts all dogus of a batch at the same time instead of one after another.
+	Parallel bool
+	// IncludeDependents restarts all dogus that directly or transitively depend on the given dogus as well.
+	IncludeDependents bool
+}
+
+// RestartDogus restarts the dogus with the given names in the order of their dependencies.
+// The descriptors must contain the given dogus and, if dependents should be included, all installed dogus.
+// Dependencies are restarted before their dependents, see OrderDogusForRestart.
+//
+// Restarting stops after the first batch containing a failed restart. All errors of that batch are returned.
+func (r *DoguRestarter) RestartDogus(ctx context.Context, doguNames []string, descriptors []*core.Dogu, opts BulkRestartOptions) error {
+	batches, err := OrderDogusForRestart(doguNames, descriptors, opts.IncludeDependents)
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range batches {
+		if opts.Parallel {
+			err = r.restartParallel(ctx, batch, opts)
+		} else {
+			err = r.restartSequential(ctx, batch, opts)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DoguRestarter) restartSequential(ctx context.Context, doguNames []string, opts BulkRestartOptions) error {
+	for _, doguName := range doguNames {
+		_, err := r.RestartDogu(ctx, doguName, toRestartOptions(doguName, opts))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DoguRestarter) restartParallel(ctx context.Context, doguNames []string, opts BulkRestartOptions) error {
+	errs := make([]error, len(doguNames))
+	var wg sync.WaitGroup
+	for i, doguName := range doguNames {
+		wg.Go(func() {
+			_, errs[i] = r.RestartDogu(ctx, doguName, toRestartOptions(doguName, opts))
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func toRestartOptions(doguName string, opts BulkRestartOptions) RestartOptions {
+	restartOpts := RestartOptions{Timeout: opts.Timeout, DeleteAfterFinished: opts.DeleteAfterFinished}
+	if opts.OnProgress != nil {
+		restartOpts.OnProgress = func(phase v2.RestartStatusPhase) {
+			opts.OnProgress(doguName, phase)
+		}
+	}
+
+	return restartOpts
+}
+
+func (r *DoguRestarter) waitForRestart(ctx context.Context, restart *v2.DoguRestart, opts RestartOptions) (*v2.DoguRestart, error) {
+	ctx, cancel := watchtools.ContextWithOptionalTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	lastObserved := restart
+	lastPhase := restart.Status.Phase
+	_, err := watchtools.UntilWithSync(ctx, newNameListWatch(r.restarts, restart.Name), &v2.DoguRestart{}, nil, func(event watch.Event) (bool, error) {
+		observed, ok := event.Object.(*v2.DoguRestart)
+		if !ok {
+			return false, nil
+		}
+
+		lastObserved = observed
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("restart %s was deleted", restart.Name)
+		}
+
+		phase := observed.Status.Phase
+		if phase != lastPhase {
+			lastPhase = phase
+			if opts.OnProgress != nil {
+				opts.OnProgress(phase)
+			}
+		}
+
+		if phase.IsFailed() {
+			return false, &DoguRestartError{DoguName: restart.Spec.DoguName, RestartName: restart.Name, Phase: phase, Message: observed.Status.Message}
+		}
+
+		return phase.IsSuccessful(), nil
+	})
+	if err != nil {
+		var restartErr *DoguRestartError
+		if errors.As(err, &restartErr) {
+			return lastObserved, err
+		}
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return lastObserved, fmt.Errorf("restart %s of dogu %s did not complete in phase %q: %w",
+			restart.Name, restart.Spec.DoguName, lastPhase, err)
+	}
+
+	return lastObserved, nil
+}
+
+// DoguRestartError is returned if a dogu restart ended in a failure phase like
+// v2.RestartStatusPhaseDoguNotFound, v2.RestartStatusPhaseFailedStop or v2.RestartStatusPhaseFailedStart.
+type DoguRestartError struct {
+	// DoguName is the name of the dogu that should have been restarted.
+	DoguName string
+	// RestartName is the name of the failed dogu restart resource.
+	RestartName string
+	// Phase is the failure phase the restart ended in.
+	Phase v2.RestartStatusPhase
+	// Message is the status message of the failed dogu restart. It may be empty.
+	Message string
+}
+
+// Error returns the error message including the failure phase and the status message.
+func (e *DoguRestartError) Error() string {
+	msg := fmt.Sprintf("restart %s of dogu %s failed: %s", e.RestartName, e.DoguName, e.Phase)
+	if e.Message == "" {
+		return msg
+	}
+
+	return fmt.Sprintf("%s: %s", msg, e.Message)
+}
+
+// Unwrap returns a *DoguNotFoundError if the restart ended in v2.RestartStatusPhaseDoguNotFound, so that callers can
+// handle missing dogus the same way for all operations.
+func (e *DoguRestartError) Unwrap() error {
+	if e.Phase == v2.RestartStatusPhaseDoguNotFound {
+		return &DoguNotFoundError{DoguName: e.DoguName}
+	}
+
+	return nil
+}
+
+// IsDoguRestartFailedWithPhase checks if the given error is a *DoguRestartError with the given phase.
+func IsDoguRestartFailedWithPhase(err error, phase v2.RestartStatusPhase) bool {
+	var restartErr *DoguRestartError
+	return errors.As(err, &restartErr) && restartErr.Phase == phase
+}
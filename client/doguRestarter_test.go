@@ -0,0 +1,304 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudogu/cesapp-lib/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	k8sv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+func newRestarterTestRestart(resourceVersion string, phase k8sv2.RestartStatusPhase) *k8sv2.DoguRestart {
+	return &k8sv2.DoguRestart{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart-abcde", Namespace: "ecosystem", ResourceVersion: resourceVersion},
+		Spec:       k8sv2.DoguRestartSpec{DoguName: "ldap"},
+		Status:     k8sv2.DoguRestartStatus{Phase: phase},
+	}
+}
+
+func byRestartName(opts metav1.ListOptions) bool {
+	return opts.FieldSelector == "metadata.name=ldap-restart-abcde"
+}
+
+func isNewLdapRestart(restart *k8sv2.DoguRestart) bool {
+	return restart.GenerateName == "ldap-restart-" && restart.Spec.DoguName == "ldap"
+}
+
+func expectRestartPhases(restartMock *MockDoguRestartInterface, phases ...k8sv2.RestartStatusPhase) {
+	watcher := watch.NewFake()
+	restartMock.EXPECT().Create(mock.Anything, mock.MatchedBy(isNewLdapRestart), metav1.CreateOptions{}).
+		Return(newRestarterTestRestart("1", k8sv2.RestartStatusPhaseNew), nil)
+	restartMock.EXPECT().List(mock.Anything, mock.MatchedBy(byRestartName)).
+		Return(&k8sv2.DoguRestartList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.DoguRestart{*newRestarterTestRestart("1", k8sv2.RestartStatusPhaseNew)}}, nil)
+	restartMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byRestartName)).
+		Run(func(ctx context.Context, opts metav1.ListOptions) {
+			go func() {
+				for _, phase := range phases {
+					watcher.Modify(newRestarterTestRestart("2", phase))
+				}
+			}()
+		}).
+		Return(watcher, nil)
+}
+
+func TestDoguRestarter_RestartDogu(t *testing.T) {
+	t.Run("should report progress until restart is completed", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		expectRestartPhases(restartMock, k8sv2.RestartStatusPhaseStopping, k8sv2.RestartStatusPhaseStopping,
+			k8sv2.RestartStatusPhaseStopped, k8sv2.RestartStatusPhaseStarting, k8sv2.RestartStatusPhaseCompleted)
+		var progress []k8sv2.RestartStatusPhase
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		actual, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{
+			Timeout:    5 * time.Second,
+			OnProgress: func(phase k8sv2.RestartStatusPhase) { progress = append(progress, phase) },
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, k8sv2.RestartStatusPhaseCompleted, actual.Status.Phase)
+		assert.Equal(t, []k8sv2.RestartStatusPhase{k8sv2.RestartStatusPhaseStopping, k8sv2.RestartStatusPhaseStopped,
+			k8sv2.RestartStatusPhaseStarting, k8sv2.RestartStatusPhaseCompleted}, progress)
+	})
+	t.Run("should return typed error on failure phase", func(t *testing.T) {
+		failurePhases := []k8sv2.RestartStatusPhase{
+			k8sv2.RestartStatusPhaseDoguNotFound,
+			k8sv2.RestartStatusPhaseFailedStop,
+			k8sv2.RestartStatusPhaseFailedStart,
+		}
+		for _, phase := range failurePhases {
+			t.Run(string(phase), func(t *testing.T) {
+				// given
+				restartMock := NewMockDoguRestartInterface(t)
+				expectRestartPhases(restartMock, phase)
+				sut := NewDoguRestarter(restartMock)
+
+				// when
+				actual, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{Timeout: 5 * time.Second})
+
+				// then
+				require.Error(t, err)
+				assert.Equal(t, phase, actual.Status.Phase)
+				assert.True(t, IsDoguRestartFailedWithPhase(err, phase))
+				var restartErr *DoguRestartError
+				require.True(t, errors.As(err, &restartErr))
+				assert.Equal(t, "ldap", restartErr.DoguName)
+				assert.Equal(t, "ldap-restart-abcde", restartErr.RestartName)
+				assert.ErrorContains(t, err, "restart ldap-restart-abcde of dogu ldap failed: "+string(phase))
+				var notFoundErr *DoguNotFoundError
+				assert.Equal(t, phase == k8sv2.RestartStatusPhaseDoguNotFound, errors.As(err, &notFoundErr))
+			})
+		}
+	})
+	t.Run("should include status message in error", func(t *testing.T) {
+		// given
+		failed := newRestarterTestRestart("2", k8sv2.RestartStatusPhaseFailedStart)
+		failed.Status.Message = "deployment did not become ready"
+		watcher := watch.NewFake()
+		restartMock := NewMockDoguRestartInterface(t)
+		restartMock.EXPECT().Create(mock.Anything, mock.MatchedBy(isNewLdapRestart), metav1.CreateOptions{}).
+			Return(newRestarterTestRestart("1", k8sv2.RestartStatusPhaseNew), nil)
+		restartMock.EXPECT().List(mock.Anything, mock.MatchedBy(byRestartName)).
+			Return(&k8sv2.DoguRestartList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil)
+		restartMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byRestartName)).
+			Run(func(ctx context.Context, opts metav1.ListOptions) {
+				go watcher.Modify(failed)
+			}).
+			Return(watcher, nil)
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		_, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "restart ldap-restart-abcde of dogu ldap failed: start failed: deployment did not become ready")
+	})
+	t.Run("should delete restart after completion", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		expectRestartPhases(restartMock, k8sv2.RestartStatusPhaseCompleted)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-abcde", metav1.DeleteOptions{}).Return(nil)
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		_, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{Timeout: 5 * time.Second, DeleteAfterFinished: true})
+
+		// then
+		require.NoError(t, err)
+	})
+	t.Run("should delete restart after failure and return both errors", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		expectRestartPhases(restartMock, k8sv2.RestartStatusPhaseFailedStart)
+		restartMock.EXPECT().Delete(mock.Anything, "ldap-restart-abcde", metav1.DeleteOptions{}).Return(assert.AnError)
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		_, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{Timeout: 5 * time.Second, DeleteAfterFinished: true})
+
+		// then
+		require.Error(t, err)
+		assert.True(t, IsDoguRestartFailedWithPhase(err, k8sv2.RestartStatusPhaseFailedStart))
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to delete restart ldap-restart-abcde of dogu ldap")
+	})
+	t.Run("should return error on timeout", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		expectRestartPhases(restartMock, k8sv2.RestartStatusPhaseStopping)
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		actual, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{Timeout: 100 * time.Millisecond})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, `restart ldap-restart-abcde of dogu ldap did not complete in phase "stopping"`)
+		assert.Equal(t, k8sv2.RestartStatusPhaseStopping, actual.Status.Phase)
+	})
+	t.Run("should return error if creating the restart fails", func(t *testing.T) {
+		// given
+		restartMock := NewMockDoguRestartInterface(t)
+		restartMock.EXPECT().Create(mock.Anything, mock.MatchedBy(isNewLdapRestart), metav1.CreateOptions{}).Return(nil, assert.AnError)
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		_, err := sut.RestartDogu(context.TODO(), "ldap", RestartOptions{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to create restart for dogu ldap")
+	})
+}
+
+func expectRestartOfDogu(restartMock *MockDoguRestartInterface, doguName string, phase k8sv2.RestartStatusPhase, created *[]string, createdMutex *sync.Mutex) {
+	restartName := doguName + "-restart-abcde"
+	byName := func(opts metav1.ListOptions) bool { return opts.FieldSelector == "metadata.name="+restartName }
+	newRestart := func(resourceVersion string, phase k8sv2.RestartStatusPhase) *k8sv2.DoguRestart {
+		return &k8sv2.DoguRestart{
+			ObjectMeta: metav1.ObjectMeta{Name: restartName, ResourceVersion: resourceVersion},
+			Spec:       k8sv2.DoguRestartSpec{DoguName: doguName},
+			Status:     k8sv2.DoguRestartStatus{Phase: phase},
+		}
+	}
+
+	watcher := watch.NewFake()
+	restartMock.EXPECT().Create(mock.Anything, mock.MatchedBy(func(restart *k8sv2.DoguRestart) bool { return restart.Spec.DoguName == doguName }), metav1.CreateOptions{}).
+		Run(func(ctx context.Context, restart *k8sv2.DoguRestart, opts metav1.CreateOptions) {
+			createdMutex.Lock()
+			defer createdMutex.Unlock()
+			*created = append(*created, doguName)
+		}).
+		Return(newRestart("1", k8sv2.RestartStatusPhaseNew), nil).Maybe()
+	restartMock.EXPECT().List(mock.Anything, mock.MatchedBy(byName)).
+		Return(&k8sv2.DoguRestartList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.DoguRestart{*newRestart("1", k8sv2.RestartStatusPhaseNew)}}, nil).Maybe()
+	restartMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byName)).
+		Run(func(ctx context.Context, opts metav1.ListOptions) {
+			go watcher.Modify(newRestart("2", phase))
+		}).
+		Return(watcher, nil).Maybe()
+}
+
+func TestDoguRestarter_RestartDogus(t *testing.T) {
+	descriptors := []*core.Dogu{
+		newOrderTestDescriptor("postgresql"),
+		newOrderTestDescriptor("redmine", "postgresql"),
+		newOrderTestDescriptor("scm"),
+		newOrderTestDescriptor("smeagol", "scm", "redmine"),
+	}
+
+	t.Run("should restart dependents sequentially after their dependencies", func(t *testing.T) {
+		// given
+		var created []string
+		var createdMutex sync.Mutex
+		restartMock := NewMockDoguRestartInterface(t)
+		for _, dogu := range []string{"postgresql", "redmine", "smeagol"} {
+			expectRestartOfDogu(restartMock, dogu, k8sv2.RestartStatusPhaseCompleted, &created, &createdMutex)
+		}
+		var progress []string
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		err := sut.RestartDogus(context.TODO(), []string{"postgresql"}, descriptors, BulkRestartOptions{
+			Timeout:           5 * time.Second,
+			IncludeDependents: true,
+			OnProgress: func(doguName string, phase k8sv2.RestartStatusPhase) {
+				progress = append(progress, doguName+"="+string(phase))
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"postgresql", "redmine", "smeagol"}, created)
+		assert.Equal(t, []string{"postgresql=completed", "redmine=completed", "smeagol=completed"}, progress)
+	})
+	t.Run("should restart batches in parallel", func(t *testing.T) {
+		// given
+		var created []string
+		var createdMutex sync.Mutex
+		restartMock := NewMockDoguRestartInterface(t)
+		for _, dogu := range []string{"postgresql", "scm", "redmine"} {
+			expectRestartOfDogu(restartMock, dogu, k8sv2.RestartStatusPhaseCompleted, &created, &createdMutex)
+		}
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		err := sut.RestartDogus(context.TODO(), []string{"redmine", "scm", "postgresql"}, descriptors, BulkRestartOptions{
+			Timeout:  5 * time.Second,
+			Parallel: true,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, created, 3)
+		assert.ElementsMatch(t, []string{"postgresql", "scm"}, created[:2])
+		assert.Equal(t, "redmine", created[2])
+	})
+	t.Run("should stop after batch with failed restarts", func(t *testing.T) {
+		// given
+		var created []string
+		var createdMutex sync.Mutex
+		restartMock := NewMockDoguRestartInterface(t)
+		expectRestartOfDogu(restartMock, "postgresql", k8sv2.RestartStatusPhaseFailedStop, &created, &createdMutex)
+		expectRestartOfDogu(restartMock, "scm", k8sv2.RestartStatusPhaseFailedStart, &created, &createdMutex)
+		expectRestartOfDogu(restartMock, "redmine", k8sv2.RestartStatusPhaseCompleted, &created, &createdMutex)
+		sut := NewDoguRestarter(restartMock)
+
+		// when
+		err := sut.RestartDogus(context.TODO(), []string{"redmine", "scm", "postgresql"}, descriptors, BulkRestartOptions{
+			Timeout:  5 * time.Second,
+			Parallel: true,
+		})
+
+		// then
+		require.Error(t, err)
+		assert.True(t, IsDoguRestartFailedWithPhase(err, k8sv2.RestartStatusPhaseFailedStop))
+		assert.ErrorContains(t, err, "restart scm-restart-abcde of dogu scm failed: start failed")
+		assert.NotContains(t, created, "redmine")
+	})
+	t.Run("should return error if dogus cannot be ordered", func(t *testing.T) {
+		// given
+		sut := NewDoguRestarter(NewMockDoguRestartInterface(t))
+
+		// when
+		err := sut.RestartDogus(context.TODO(), []string{"jenkins"}, descriptors, BulkRestartOptions{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "missing descriptor for dogu jenkins")
+	})
+}
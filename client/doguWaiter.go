@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+// WaitOptions configure how long a DoguWaiter waits for a dogu.
+type WaitOptions struct {
+	// Timeout is the maximum duration to wait. A zero value waits until the given context is done.
+	Timeout time.Duration
+}
+
+// DoguConditionFunc checks if the given dogu reached the awaited state.
+// Returning an error aborts waiting.
+type DoguConditionFunc func(dogu *v2.Dogu) (bool, error)
+
+// DoguWaiter blocks until dogus reach a target state. It watches the dogus and transparently resumes the watch
+// if it expires or the connection gets lost.
+type DoguWaiter struct {
+	dogus DoguInterface
+}
+
+// NewDoguWaiter creates a DoguWaiter which observes dogus with the given client.
+func NewDoguWaiter(dogus DoguInterface) *DoguWaiter {
+	return &DoguWaiter{dogus: dogus}
+}
+
+// WaitForDoguReady blocks until the ready condition of the dogu with the given name is true.
+func (w *DoguWaiter) WaitForDoguReady(ctx context.Context, name string, opts WaitOptions) (*v2.Dogu, error) {
+	return w.WaitForCondition(ctx, name, v2.ConditionReady, metav1.ConditionTrue, opts)
+}
+
+// WaitForInstalledVersion blocks until the dogu with the given name reports the given version as installed.
+func (w *DoguWaiter) WaitForInstalledVersion(ctx context.Context, name string, version string, opts WaitOptions) (*v2.Dogu, error) {
+	return w.WaitFor(ctx, name, fmt.Sprintf("have version %s installed", version), func(dogu *v2.Dogu) (bool, error) {
+		return dogu.Status.InstalledVersion == version, nil
+	}, opts)
+}
+
+// WaitForCondition blocks until the condition with the given type of the dogu with the given name has the given status.
+func (w *DoguWaiter) WaitForCondition(ctx context.Context, name string, conditionType string, status metav1.ConditionStatus, opts WaitOptions) (*v2.Dogu, error) {
+	return w.WaitFor(ctx, name, fmt.Sprintf("have condition %s=%s", conditionType, status), func(dogu *v2.Dogu) (bool, error) {
+		return meta.IsStatusConditionPresentAndEqual(dogu.Status.Conditions, conditionType, status), nil
+	}, opts)
+}
+
+// WaitFor blocks until the given condition is met for the dogu with the given name. The dogu does not need to exist
+// when calling this method. The description of the awaited state is used in errors.
+//
+// If the timeout expires or the context is done, a *DoguWaitError is returned containing the last observed dogu.
+// An error is also returned if the dogu gets deleted while waiting.
+func (w *DoguWaiter) WaitFor(ctx context.Context, name string, description string, condition DoguConditionFunc, opts WaitOptions) (*v2.Dogu, error) {
+	ctx, cancel := watchtools.ContextWithOptionalTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	var lastObserved *v2.Dogu
+	_, err := watchtools.UntilWithSync(ctx, newNameListWatch(w.dogus, name), &v2.Dogu{}, nil, func(event watch.Event) (bool, error) {
+		dogu, ok := event.Object.(*v2.Dogu)
+		if !ok {
+			return false, nil
+		}
+
+		lastObserved = dogu
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("dogu %s was deleted", name)
+		}
+
+		return condition(dogu)
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return nil, &DoguWaitError{DoguName: name, Awaited: description, LastObserved: lastObserved, Err: err}
+	}
+
+	return lastObserved, nil
+}
+
+// DoguWaitError is returned if a dogu did not reach the awaited state.
+type DoguWaitError struct {
+	// DoguName is the name of the awaited dogu.
+	DoguName string
+	// Awaited describes the state the dogu did not reach.
+	Awaited string
+	// LastObserved is the last observed state of the dogu. It is nil if the dogu was never observed.
+	LastObserved *v2.Dogu
+	// Err is the reason why waiting was aborted, e.g. context.DeadlineExceeded.
+	Err error
+}
+
+// Error returns the error message including the last observed installed version and conditions of the dogu.
+func (e *DoguWaitError) Error() string {
+	msg := fmt.Sprintf("dogu %s did not %s: %v", e.DoguName, e.Awaited, e.Err)
+	if e.LastObserved == nil {
+		return msg + "; dogu was never observed"
+	}
+
+	return fmt.Sprintf("%s; last observed installed version: %q, conditions: %s",
+		msg, e.LastObserved.Status.InstalledVersion, formatConditions(e.LastObserved.Status.Conditions))
+}
+
+// Unwrap returns the reason why waiting was aborted.
+func (e *DoguWaitError) Unwrap() error {
+	return e.Err
+}
+
+func formatConditions(conditions []metav1.Condition) string {
+	if len(conditions) == 0 {
+		return "none"
+	}
+
+	formatted := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		formatted = append(formatted, fmt.Sprintf("%s=%s (reason: %q, message: %q)", condition.Type, condition.Status, condition.Reason, condition.Message))
+	}
+
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	k8sv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+func newWaiterTestDogu(resourceVersion string, conditions ...metav1.Condition) *k8sv2.Dogu {
+	return &k8sv2.Dogu{
+		ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "ecosystem", ResourceVersion: resourceVersion},
+		Status:     k8sv2.DoguStatus{InstalledVersion: "1.2.3-4", Conditions: conditions},
+	}
+}
+
+func byDoguName(opts metav1.ListOptions) bool {
+	return opts.FieldSelector == "metadata.name=ldap"
+}
+
+func TestDoguWaiter_WaitForDoguReady(t *testing.T) {
+	readyCondition := metav1.Condition{Type: k8sv2.ConditionReady, Status: metav1.ConditionTrue, Reason: "Ready"}
+	notReadyCondition := metav1.Condition{Type: k8sv2.ConditionReady, Status: metav1.ConditionFalse, Reason: "PodsNotReady", Message: "0/1 pods are ready"}
+
+	t.Run("should return immediately if dogu is already ready", func(t *testing.T) {
+		// given
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{Items: []k8sv2.Dogu{*newWaiterTestDogu("1", readyCondition)}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).Return(watch.NewFake(), nil).Maybe()
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		actual, err := sut.WaitForDoguReady(context.TODO(), "ldap", WaitOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ldap", actual.Name)
+	})
+	t.Run("should wait until dogu becomes ready", func(t *testing.T) {
+		// given
+		watcher := watch.NewFake()
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.Dogu{*newWaiterTestDogu("1", notReadyCondition)}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).
+			Run(func(ctx context.Context, opts metav1.ListOptions) {
+				go watcher.Modify(newWaiterTestDogu("2", readyCondition))
+			}).
+			Return(watcher, nil)
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		actual, err := sut.WaitForDoguReady(context.TODO(), "ldap", WaitOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2", actual.ResourceVersion)
+	})
+	t.Run("should resume after the watch expired", func(t *testing.T) {
+		// given
+		expiredWatcher := watch.NewFake()
+		watcher := watch.NewFake()
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.Dogu{*newWaiterTestDogu("1", notReadyCondition)}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).
+			Run(func(ctx context.Context, opts metav1.ListOptions) {
+				go expiredWatcher.Stop()
+			}).
+			Return(expiredWatcher, nil).Once()
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).
+			Run(func(ctx context.Context, opts metav1.ListOptions) {
+				go watcher.Modify(newWaiterTestDogu("2", readyCondition))
+			}).
+			Return(watcher, nil).Once()
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		actual, err := sut.WaitForDoguReady(context.TODO(), "ldap", WaitOptions{Timeout: 10 * time.Second})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2", actual.ResourceVersion)
+	})
+	t.Run("should return error with last observed conditions on timeout", func(t *testing.T) {
+		// given
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.Dogu{*newWaiterTestDogu("1", notReadyCondition)}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).Return(watch.NewFake(), nil)
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		_, err := sut.WaitForDoguReady(context.TODO(), "ldap", WaitOptions{Timeout: 100 * time.Millisecond})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		var waitErr *DoguWaitError
+		require.True(t, errors.As(err, &waitErr))
+		assert.Equal(t, "ldap", waitErr.DoguName)
+		require.NotNil(t, waitErr.LastObserved)
+		assert.ErrorContains(t, err, "dogu ldap did not have condition ready=True: context deadline exceeded")
+		assert.ErrorContains(t, err, `last observed installed version: "1.2.3-4", conditions: ready=False (reason: "PodsNotReady", message: "0/1 pods are ready")`)
+	})
+	t.Run("should return error if dogu was never observed", func(t *testing.T) {
+		// given
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).Return(watch.NewFake(), nil)
+		ctx, cancel := context.WithCancel(context.TODO())
+		time.AfterFunc(100*time.Millisecond, cancel)
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		_, err := sut.WaitForDoguReady(ctx, "ldap", WaitOptions{})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorContains(t, err, "dogu was never observed")
+	})
+	t.Run("should return error if dogu gets deleted", func(t *testing.T) {
+		// given
+		watcher := watch.NewFake()
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.Dogu{*newWaiterTestDogu("1", notReadyCondition)}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).
+			Run(func(ctx context.Context, opts metav1.ListOptions) {
+				go watcher.Delete(newWaiterTestDogu("2", notReadyCondition))
+			}).
+			Return(watcher, nil)
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		_, err := sut.WaitForDoguReady(context.TODO(), "ldap", WaitOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "dogu ldap was deleted")
+	})
+}
+
+func TestDoguWaiter_WaitForInstalledVersion(t *testing.T) {
+	t.Run("should wait until version is installed", func(t *testing.T) {
+		// given
+		watcher := watch.NewFake()
+		upgraded := newWaiterTestDogu("2")
+		upgraded.Status.InstalledVersion = "1.2.3-5"
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: []k8sv2.Dogu{*newWaiterTestDogu("1")}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).
+			Run(func(ctx context.Context, opts metav1.ListOptions) {
+				go watcher.Modify(upgraded)
+			}).
+			Return(watcher, nil)
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		actual, err := sut.WaitForInstalledVersion(context.TODO(), "ldap", "1.2.3-5", WaitOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3-5", actual.Status.InstalledVersion)
+	})
+}
+
+func TestDoguWaiter_WaitForCondition(t *testing.T) {
+	t.Run("should wait for unhealthy condition", func(t *testing.T) {
+		// given
+		unhealthy := metav1.Condition{Type: k8sv2.ConditionHealthy, Status: metav1.ConditionFalse}
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{Items: []k8sv2.Dogu{*newWaiterTestDogu("1", unhealthy)}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).Return(watch.NewFake(), nil).Maybe()
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		actual, err := sut.WaitForCondition(context.TODO(), "ldap", k8sv2.ConditionHealthy, metav1.ConditionFalse, WaitOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ldap", actual.Name)
+	})
+	t.Run("should abort on error of the condition function", func(t *testing.T) {
+		// given
+		doguMock := NewMockDoguInterface(t)
+		doguMock.EXPECT().List(mock.Anything, mock.MatchedBy(byDoguName)).
+			Return(&k8sv2.DoguList{Items: []k8sv2.Dogu{*newWaiterTestDogu("1")}}, nil)
+		doguMock.EXPECT().Watch(mock.Anything, mock.MatchedBy(byDoguName)).Return(watch.NewFake(), nil).Maybe()
+		sut := NewDoguWaiter(doguMock)
+
+		// when
+		_, err := sut.WaitFor(context.TODO(), "ldap", "pass the check", func(dogu *k8sv2.Dogu) (bool, error) {
+			return false, assert.AnError
+		}, WaitOptions{Timeout: 5 * time.Second})
+
+		// then
+		require.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "dogu ldap did not pass the check")
+	})
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	doguResource        = "dogu"
+	doguRestartResource = "dogu restart"
+)
+
+// DoguNotFoundError is returned if a dogu resource does not exist. It is not returned when creating or applying a
+// dogu, because a not found error there usually means that the namespace does not exist.
+type DoguNotFoundError struct {
+	// DoguName is the name of the missing dogu.
+	DoguName string
+	// Err is the underlying error, e.g. the API error. It may be nil.
+	Err error
+}
+
+// Error returns the error message including the name of the missing dogu.
+func (e *DoguNotFoundError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("dogu %s not found", e.DoguName)
+	}
+
+	return fmt.Sprintf("dogu %s not found: %v", e.DoguName, e.Err)
+}
+
+// Unwrap returns the underlying error, so that e.g. apierrors.IsNotFound can still be used.
+func (e *DoguNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictError is returned if a resource could not be modified because it was changed in the meantime.
+// Fetching the resource again and retrying the modification usually resolves the conflict.
+type ConflictError struct {
+	// Resource is the kind of the conflicting resource, e.g. "dogu" or "dogu restart".
+	Resource string
+	// Name is the name of the conflicting resource.
+	Name string
+	// Err is the underlying API error.
+	Err error
+}
+
+// Error returns the error message including the kind and the name of the conflicting resource.
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict while modifying %s %s: %v", e.Resource, e.Name, e.Err)
+}
+
+// Unwrap returns the underlying API error, so that e.g. apierrors.IsConflict can still be used.
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError is returned if a resource was rejected because of invalid fields.
+type ValidationError struct {
+	// Resource is the kind of the invalid resource, e.g. "dogu" or "dogu restart".
+	Resource string
+	// Name is the name of the invalid resource.
+	Name string
+	// Causes contains the invalid fields with the reasons why they are invalid. It may be empty.
+	Causes []metav1.StatusCause
+	// Err is the underlying API error.
+	Err error
+}
+
+// Error returns the error message including the invalid fields.
+func (e *ValidationError) Error() string {
+	if len(e.Causes) == 0 {
+		return fmt.Sprintf("%s %s is invalid: %v", e.Resource, e.Name, e.Err)
+	}
+
+	causes := make([]string, 0, len(e.Causes))
+	for _, cause := range e.Causes {
+		causes = append(causes, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+	}
+
+	return fmt.Sprintf("%s %s is invalid: %s", e.Resource, e.Name, strings.Join(causes, ", "))
+}
+
+// Unwrap returns the underlying API error, so that e.g. apierrors.IsInvalid can still be used.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// HasFieldCause checks if the given field, e.g. "spec.name", is one of the invalid fields.
+func (e *ValidationError) HasFieldCause(field string) bool {
+	for _, cause := range e.Causes {
+		if cause.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toDoguError(name string, err error) error {
+	if apierrors.IsNotFound(err) {
+		return &DoguNotFoundError{DoguName: name, Err: err}
+	}
+
+	return toResourceError(doguResource, name, err)
+}
+
+func toDoguRestartError(name string, err error) error {
+	return toResourceError(doguRestartResource, name, err)
+}
+
+func toResourceError(resource, name string, err error) error {
+	switch {
+	case apierrors.IsConflict(err):
+		return &ConflictError{Resource: resource, Name: name, Err: err}
+	case apierrors.IsInvalid(err):
+		return &ValidationError{Resource: resource, Name: name, Causes: statusCauses(err), Err: err}
+	default:
+		return err
+	}
+}
+
+func statusCauses(err error) []metav1.StatusCause {
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) || apiStatus.Status().Details == nil {
+		return nil
+	}
+
+	return apiStatus.Status().Details.Causes
+}
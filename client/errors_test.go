@@ -0,0 +1,112 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var testDoguResource = schema.GroupResource{Group: "k8s.cloudogu.com", Resource: "dogus"}
+
+func Test_toDoguError(t *testing.T) {
+	t.Run("should return nil for nil", func(t *testing.T) {
+		assert.NoError(t, toDoguError("ldap", nil))
+	})
+	t.Run("should return DoguNotFoundError", func(t *testing.T) {
+		// given
+		apiErr := apierrors.NewNotFound(testDoguResource, "ldap")
+
+		// when
+		err := toDoguError("ldap", apiErr)
+
+		// then
+		var notFoundErr *DoguNotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		assert.Equal(t, "ldap", notFoundErr.DoguName)
+		assert.True(t, apierrors.IsNotFound(err))
+		assert.EqualError(t, err, `dogu ldap not found: dogus.k8s.cloudogu.com "ldap" not found`)
+	})
+	t.Run("should return ConflictError", func(t *testing.T) {
+		// given
+		apiErr := apierrors.NewConflict(testDoguResource, "ldap", assert.AnError)
+
+		// when
+		err := toDoguError("ldap", apiErr)
+
+		// then
+		var conflictErr *ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.Equal(t, "dogu", conflictErr.Resource)
+		assert.Equal(t, "ldap", conflictErr.Name)
+		assert.True(t, apierrors.IsConflict(err))
+		assert.ErrorContains(t, err, "conflict while modifying dogu ldap")
+	})
+	t.Run("should return ValidationError with field causes", func(t *testing.T) {
+		// given
+		apiErr := apierrors.NewInvalid(schema.GroupKind{Group: "k8s.cloudogu.com", Kind: "Dogu"}, "ldap", field.ErrorList{
+			field.Invalid(field.NewPath("spec", "version"), "abc", "not a version"),
+			field.Required(field.NewPath("spec", "name"), ""),
+		})
+
+		// when
+		err := toDoguError("ldap", apiErr)
+
+		// then
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.True(t, apierrors.IsInvalid(err))
+		require.Len(t, validationErr.Causes, 2)
+		assert.True(t, validationErr.HasFieldCause("spec.version"))
+		assert.True(t, validationErr.HasFieldCause("spec.name"))
+		assert.False(t, validationErr.HasFieldCause("spec.security"))
+		assert.ErrorContains(t, err, `dogu ldap is invalid: spec.version: Invalid value: "abc": not a version, spec.name: Required value`)
+	})
+	t.Run("should return other errors unchanged", func(t *testing.T) {
+		assert.Same(t, assert.AnError, toDoguError("ldap", assert.AnError))
+	})
+}
+
+func Test_toDoguRestartError(t *testing.T) {
+	t.Run("should not return DoguNotFoundError for missing dogu restart", func(t *testing.T) {
+		// given
+		apiErr := apierrors.NewNotFound(schema.GroupResource{Group: "k8s.cloudogu.com", Resource: "dogurestarts"}, "ldap-restart")
+
+		// when
+		err := toDoguRestartError("ldap-restart", apiErr)
+
+		// then
+		var notFoundErr *DoguNotFoundError
+		assert.False(t, errors.As(err, &notFoundErr))
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+	t.Run("should return ConflictError", func(t *testing.T) {
+		// given
+		apiErr := apierrors.NewConflict(testDoguResource, "ldap-restart", assert.AnError)
+
+		// when
+		err := toDoguRestartError("ldap-restart", apiErr)
+
+		// then
+		var conflictErr *ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.Equal(t, "dogu restart", conflictErr.Resource)
+	})
+}
+
+func TestValidationError_Error(t *testing.T) {
+	t.Run("should use underlying error without causes", func(t *testing.T) {
+		// given
+		sut := &ValidationError{Resource: "dogu", Name: "ldap", Err: assert.AnError}
+
+		// when
+		actual := sut.Error()
+
+		// then
+		assert.Equal(t, "dogu ldap is invalid: "+assert.AnError.Error(), actual)
+	})
+}
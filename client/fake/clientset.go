@@ -0,0 +1,196 @@
+// Package fake provides an in-memory implementation of client.EcoSystemV2Interface backed by an object tracker.
+// It is meant to be used in unit tests of components which work with dogus and dogu restarts.
+package fake
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/testing"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client"
+)
+
+var scheme = runtime.NewScheme()
+var codecs = serializer.NewCodecFactory(scheme)
+
+func init() {
+	metav1.AddToGroupVersion(scheme, schema.GroupVersion{Version: "v1"})
+	utilruntime.Must(v2.AddToScheme(scheme))
+}
+
+var _ client.EcoSystemV2Interface = &Clientset{}
+
+// Clientset implements client.EcoSystemV2Interface on top of an in-memory object tracker.
+//
+// Errors can be injected by prepending reactors, e.g.:
+//
+//	clientset.PrependReactor("update", "dogus", func(action testing.Action) (bool, runtime.Object, error) {
+//		return true, nil, apierrors.NewConflict(...)
+//	})
+//
+// All invoked actions are recorded and can be inspected with Actions.
+type Clientset struct {
+	testing.Fake
+	tracker testing.ObjectTracker
+}
+
+// NewClientset returns a clientset that responds with the provided objects.
+// It processes creates, updates, patches and deletions as-is without any validation or defaulting
+// and is not meant as a replacement for a real cluster. Server-side apply is supported including field management.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	o := testing.NewFieldManagedObjectTracker(
+		scheme,
+		codecs.UniversalDecoder(),
+		// the CRD types come without an OpenAPI schema, so the structure for field management is deduced from the objects
+		managedfields.NewDeducedTypeConverter(),
+	)
+	for _, obj := range objects {
+		if err := o.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	cs := &Clientset{tracker: o}
+	cs.AddReactor("create", "*", generateNameReaction)
+	cs.AddReactor("delete-collection", "*", deleteCollectionReaction(o))
+	cs.AddReactor("*", "*", testing.ObjectReaction(o))
+	cs.AddWatchReactor("*", func(action testing.Action) (handled bool, ret watch.Interface, err error) {
+		var opts metav1.ListOptions
+		if watchAction, ok := action.(testing.WatchActionImpl); ok {
+			opts = watchAction.ListOptions
+		}
+		w, err := o.Watch(action.GetResource(), action.GetNamespace(), opts)
+		if err != nil {
+			return false, nil, err
+		}
+		return true, filterWatch(w, opts), nil
+	})
+
+	return cs
+}
+
+// Tracker returns the object tracker which holds the objects of this clientset.
+func (c *Clientset) Tracker() testing.ObjectTracker {
+	return c.tracker
+}
+
+// Dogus returns a fake client for dogus in the given namespace.
+func (c *Clientset) Dogus(namespace string) client.DoguInterface {
+	return newFakeDogus(&c.Fake, namespace)
+}
+
+// DoguRestarts returns a fake client for dogu restarts in the given namespace.
+func (c *Clientset) DoguRestarts(namespace string) client.DoguRestartInterface {
+	return newFakeDoguRestarts(&c.Fake, namespace)
+}
+
+// generateNameReaction fills in the name of objects which are created with metadata.generateName, like the API server
+// does. It does not handle the action so that the object tracker can create the object afterward.
+func generateNameReaction(action testing.Action) (bool, runtime.Object, error) {
+	createAction, ok := action.(testing.CreateAction)
+	if !ok || createAction.GetSubresource() != "" {
+		return false, nil, nil
+	}
+
+	objMeta, err := meta.Accessor(createAction.GetObject())
+	if err != nil {
+		return false, nil, nil
+	}
+	if objMeta.GetName() == "" && objMeta.GetGenerateName() != "" {
+		objMeta.SetName(objMeta.GetGenerateName() + utilrand.String(5))
+	}
+
+	return false, nil, nil
+}
+
+// deleteCollectionReaction deletes all objects of the action's resource and namespace matching the list options.
+func deleteCollectionReaction(tracker testing.ObjectTracker) testing.ReactionFunc {
+	return func(action testing.Action) (bool, runtime.Object, error) {
+		deleteAction, ok := action.(testing.DeleteCollectionActionImpl)
+		if !ok {
+			return false, nil, nil
+		}
+
+		gvr := deleteAction.GetResource()
+		gvk, err := kindFor(gvr)
+		if err != nil {
+			return true, nil, err
+		}
+
+		list, err := tracker.List(gvr, gvk, deleteAction.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return true, nil, err
+		}
+
+		for _, item := range items {
+			if !matchesListOptions(item, deleteAction.ListOptions) {
+				continue
+			}
+			objMeta, err := meta.Accessor(item)
+			if err != nil {
+				return true, nil, err
+			}
+			err = tracker.Delete(gvr, objMeta.GetNamespace(), objMeta.GetName(), deleteAction.DeleteOptions)
+			if err != nil {
+				return true, nil, err
+			}
+		}
+
+		return true, nil, nil
+	}
+}
+
+func kindFor(gvr schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	switch gvr {
+	case doguResource:
+		return doguKind, nil
+	case doguRestartResource:
+		return doguRestartKind, nil
+	default:
+		return schema.GroupVersionKind{}, fmt.Errorf("unknown resource %s", gvr)
+	}
+}
+
+// filterWatch removes all events from the watcher whose objects do not match the label and field selectors of opts.
+func filterWatch(w watch.Interface, opts metav1.ListOptions) watch.Interface {
+	if opts.LabelSelector == "" && opts.FieldSelector == "" {
+		return w
+	}
+
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		return in, matchesListOptions(in.Object, opts)
+	})
+}
+
+// matchesListOptions checks the object against the label selector and the metadata.name and metadata.namespace field
+// selectors of the given list options.
+func matchesListOptions(obj runtime.Object, opts metav1.ListOptions) bool {
+	labelSelector, fieldSelector, _ := testing.ExtractFromListOptions(opts)
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+
+	fieldSet := fields.Set{
+		"metadata.name":      objMeta.GetName(),
+		"metadata.namespace": objMeta.GetNamespace(),
+	}
+
+	return labelSelector.Matches(labels.Set(objMeta.GetLabels())) && fieldSelector.Matches(fieldSet)
+}
@@ -0,0 +1,386 @@
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
+)
+
+var testCtx = context.Background()
+
+func newTestDogu(name string, labels map[string]string) *v2.Dogu {
+	return &v2.Dogu{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ecosystem", Labels: labels},
+		Spec:       v2.DoguSpec{Name: "official/" + name, Version: "1.2.3-4"},
+	}
+}
+
+func TestNewClientset(t *testing.T) {
+	t.Run("should return initial objects", func(t *testing.T) {
+		// given
+		sut := NewClientset(newTestDogu("ldap", nil), &v2.DoguRestart{ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart", Namespace: "ecosystem"}})
+
+		// when
+		dogu, doguErr := sut.Dogus("ecosystem").Get(testCtx, "ldap", metav1.GetOptions{})
+		restart, restartErr := sut.DoguRestarts("ecosystem").Get(testCtx, "ldap-restart", metav1.GetOptions{})
+
+		// then
+		require.NoError(t, doguErr)
+		assert.Equal(t, "official/ldap", dogu.Spec.Name)
+		require.NoError(t, restartErr)
+		assert.Equal(t, "ldap-restart", restart.Name)
+	})
+	t.Run("should panic on unknown objects", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewClientset(&runtime.Unknown{})
+		})
+	})
+}
+
+func TestClientset_Dogus(t *testing.T) {
+	t.Run("should create, update and delete dogu", func(t *testing.T) {
+		// given
+		sut := NewClientset().Dogus("ecosystem")
+
+		// when
+		created, createErr := sut.Create(testCtx, newTestDogu("ldap", nil), metav1.CreateOptions{})
+		created.Spec.Version = "1.2.3-5"
+		updated, updateErr := sut.Update(testCtx, created, metav1.UpdateOptions{})
+		updated.Status.InstalledVersion = "1.2.3-5"
+		_, statusErr := sut.UpdateStatus(testCtx, updated, metav1.UpdateOptions{})
+		actual, getErr := sut.Get(testCtx, "ldap", metav1.GetOptions{})
+		deleteErr := sut.Delete(testCtx, "ldap", metav1.DeleteOptions{})
+		_, getAfterDeleteErr := sut.Get(testCtx, "ldap", metav1.GetOptions{})
+
+		// then
+		require.NoError(t, createErr)
+		require.NoError(t, updateErr)
+		require.NoError(t, statusErr)
+		require.NoError(t, getErr)
+		assert.Equal(t, "1.2.3-5", actual.Spec.Version)
+		assert.Equal(t, "1.2.3-5", actual.Status.InstalledVersion)
+		require.NoError(t, deleteErr)
+		assert.True(t, apierrors.IsNotFound(getAfterDeleteErr))
+	})
+	t.Run("should return already exists error", func(t *testing.T) {
+		// given
+		sut := NewClientset(newTestDogu("ldap", nil)).Dogus("ecosystem")
+
+		// when
+		_, err := sut.Create(testCtx, newTestDogu("ldap", nil), metav1.CreateOptions{})
+
+		// then
+		assert.True(t, apierrors.IsAlreadyExists(err))
+	})
+	t.Run("should generate name", func(t *testing.T) {
+		// given
+		sut := NewClientset().Dogus("ecosystem")
+		dogu := newTestDogu("", nil)
+		dogu.GenerateName = "ldap-"
+
+		// when
+		actual, err := sut.Create(testCtx, dogu, metav1.CreateOptions{})
+
+		// then
+		require.NoError(t, err)
+		assert.Regexp(t, "^ldap-[a-z0-9]{5}$", actual.Name)
+	})
+	t.Run("should list dogus by label and field selector", func(t *testing.T) {
+		// given
+		sut := NewClientset(
+			newTestDogu("ldap", map[string]string{"app": "ces"}),
+			newTestDogu("cas", map[string]string{"app": "ces"}),
+			newTestDogu("redmine", nil),
+		).Dogus("ecosystem")
+
+		// when
+		byLabel, labelErr := sut.List(testCtx, metav1.ListOptions{LabelSelector: "app=ces"})
+		byField, fieldErr := sut.List(testCtx, metav1.ListOptions{FieldSelector: "metadata.name=redmine"})
+
+		// then
+		require.NoError(t, labelErr)
+		assert.ElementsMatch(t, []string{"ldap", "cas"}, doguNames(byLabel))
+		require.NoError(t, fieldErr)
+		assert.Equal(t, []string{"redmine"}, doguNames(byField))
+	})
+	t.Run("should only list dogus of the given namespace", func(t *testing.T) {
+		// given
+		other := newTestDogu("ldap", nil)
+		other.Namespace = "other"
+		cs := NewClientset(newTestDogu("cas", nil), other)
+
+		// when
+		namespaced, namespacedErr := cs.Dogus("other").List(testCtx, metav1.ListOptions{})
+		all, allErr := cs.Dogus(metav1.NamespaceAll).List(testCtx, metav1.ListOptions{})
+
+		// then
+		require.NoError(t, namespacedErr)
+		assert.Equal(t, []string{"ldap"}, doguNames(namespaced))
+		require.NoError(t, allErr)
+		assert.Len(t, all.Items, 2)
+	})
+	t.Run("should delete collection by label selector", func(t *testing.T) {
+		// given
+		sut := NewClientset(
+			newTestDogu("ldap", map[string]string{"app": "ces"}),
+			newTestDogu("cas", map[string]string{"app": "ces"}),
+			newTestDogu("redmine", nil),
+		).Dogus("ecosystem")
+
+		// when
+		err := sut.DeleteCollection(testCtx, metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=ces"})
+
+		// then
+		require.NoError(t, err)
+		remaining, err := sut.List(testCtx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"redmine"}, doguNames(remaining))
+	})
+}
+
+func TestClientset_Dogus_Scale(t *testing.T) {
+	t.Run("should get and update scale", func(t *testing.T) {
+		// given
+		dogu := newTestDogu("ldap", nil)
+		dogu.Status.Replicas = 1
+		dogu.Status.Selector = "dogu.name=ldap"
+		sut := NewClientset(dogu).Dogus("ecosystem")
+
+		// when
+		scale, getErr := sut.GetScale(testCtx, "ldap", metav1.GetOptions{})
+		scale.Spec.Replicas = 3
+		updatedScale, updateErr := sut.UpdateScale(testCtx, "ldap", scale, metav1.UpdateOptions{})
+		updatedDogu, _ := sut.Get(testCtx, "ldap", metav1.GetOptions{})
+
+		// then
+		require.NoError(t, getErr)
+		assert.Equal(t, int32(1), scale.Status.Replicas)
+		assert.Equal(t, "dogu.name=ldap", scale.Status.Selector)
+		require.NoError(t, updateErr)
+		assert.Equal(t, int32(3), updatedScale.Spec.Replicas)
+		assert.Equal(t, int32(3), updatedDogu.GetReplicas())
+	})
+	t.Run("should fail for missing dogu", func(t *testing.T) {
+		// given
+		sut := NewClientset().Dogus("ecosystem")
+
+		// when
+		_, err := sut.GetScale(testCtx, "ldap", metav1.GetOptions{})
+
+		// then
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func TestClientset_Dogus_Patch(t *testing.T) {
+	tests := []struct {
+		name      string
+		patchType types.PatchType
+		patch     string
+	}{
+		{name: "merge patch", patchType: types.MergePatchType, patch: `{"spec":{"version":"1.2.3-5"}}`},
+		{name: "json patch", patchType: types.JSONPatchType, patch: `[{"op":"replace","path":"/spec/version","value":"1.2.3-5"}]`},
+		{name: "strategic merge patch", patchType: types.StrategicMergePatchType, patch: `{"spec":{"version":"1.2.3-5"}}`},
+	}
+	for _, tt := range tests {
+		t.Run("should apply "+tt.name, func(t *testing.T) {
+			// given
+			sut := NewClientset(newTestDogu("ldap", nil)).Dogus("ecosystem")
+
+			// when
+			actual, err := sut.Patch(testCtx, "ldap", tt.patchType, []byte(tt.patch), metav1.PatchOptions{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, "1.2.3-5", actual.Spec.Version)
+			assert.Equal(t, "official/ldap", actual.Spec.Name)
+		})
+	}
+}
+
+func TestClientset_Dogus_Watch(t *testing.T) {
+	t.Run("should only send events for dogus matching the label selector", func(t *testing.T) {
+		// given
+		sut := NewClientset().Dogus("ecosystem")
+		watcher, err := sut.Watch(testCtx, metav1.ListOptions{LabelSelector: "app=ces"})
+		require.NoError(t, err)
+		defer watcher.Stop()
+
+		// when
+		_, err = sut.Create(testCtx, newTestDogu("redmine", nil), metav1.CreateOptions{})
+		require.NoError(t, err)
+		_, err = sut.Create(testCtx, newTestDogu("ldap", map[string]string{"app": "ces"}), metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		// then
+		select {
+		case event := <-watcher.ResultChan():
+			assert.Equal(t, watch.Added, event.Type)
+			assert.Equal(t, "ldap", event.Object.(*v2.Dogu).Name)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for watch event")
+		}
+	})
+}
+
+func TestClientset_Dogus_UpdateWithRetry(t *testing.T) {
+	t.Run("should retry spec update on conflict", func(t *testing.T) {
+		// given
+		cs := NewClientset(newTestDogu("ldap", nil))
+		conflicts := 1
+		cs.PrependReactor("update", "dogus", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if conflicts > 0 {
+				conflicts--
+				return true, nil, apierrors.NewConflict(doguResource.GroupResource(), "ldap", assert.AnError)
+			}
+			return false, nil, nil
+		})
+		sut := cs.Dogus("ecosystem")
+
+		// when
+		actual, err := sut.UpdateSpecWithRetry(testCtx, newTestDogu("ldap", nil), func(spec v2.DoguSpec) v2.DoguSpec {
+			spec.Stopped = true
+			return spec
+		}, metav1.UpdateOptions{})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, actual.Spec.Stopped)
+		assert.Equal(t, 0, conflicts)
+	})
+	t.Run("should update status", func(t *testing.T) {
+		// given
+		sut := NewClientset(newTestDogu("ldap", nil)).Dogus("ecosystem")
+
+		// when
+		actual, err := sut.UpdateStatusWithRetry(testCtx, newTestDogu("ldap", nil), func(status v2.DoguStatus) v2.DoguStatus {
+			status.InstalledVersion = "1.2.3-4"
+			return status
+		}, metav1.UpdateOptions{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.2.3-4", actual.Status.InstalledVersion)
+	})
+}
+
+func TestClientset_Dogus_Apply(t *testing.T) {
+	t.Run("should merge fields of different field managers", func(t *testing.T) {
+		// given
+		sut := NewClientset().Dogus("ecosystem")
+		mounts := acv2.Dogu("ldap", "ecosystem").WithSpec(acv2.DoguSpec().
+			WithName("official/ldap").
+			WithAdditionalMounts(acv2.DataMount().WithSourceType(v2.DataSourceConfigMap).WithName("my-config").WithVolume("config")))
+		security := acv2.Dogu("ldap", "ecosystem").WithSpec(acv2.DoguSpec().
+			WithSecurity(acv2.Security().WithRunAsNonRoot(true)))
+
+		// when
+		_, mountsErr := sut.Apply(testCtx, mounts, metav1.ApplyOptions{FieldManager: "mount-manager"})
+		actual, securityErr := sut.Apply(testCtx, security, metav1.ApplyOptions{FieldManager: "security-manager"})
+
+		// then
+		require.NoError(t, mountsErr)
+		require.NoError(t, securityErr)
+		assert.Equal(t, "official/ldap", actual.Spec.Name)
+		require.Len(t, actual.Spec.AdditionalMounts, 1)
+		assert.Equal(t, "my-config", actual.Spec.AdditionalMounts[0].Name)
+		require.NotNil(t, actual.Spec.Security.RunAsNonRoot)
+		assert.True(t, *actual.Spec.Security.RunAsNonRoot)
+	})
+	t.Run("should return conflict for field owned by other manager", func(t *testing.T) {
+		// given
+		sut := NewClientset().Dogus("ecosystem")
+		_, err := sut.Apply(testCtx, acv2.Dogu("ldap", "ecosystem").WithSpec(acv2.DoguSpec().WithVersion("1.2.3-4")), metav1.ApplyOptions{FieldManager: "first"})
+		require.NoError(t, err)
+
+		// when
+		_, err = sut.Apply(testCtx, acv2.Dogu("ldap", "ecosystem").WithSpec(acv2.DoguSpec().WithVersion("1.2.3-5")), metav1.ApplyOptions{FieldManager: "second"})
+
+		// then
+		assert.True(t, apierrors.IsConflict(err))
+	})
+}
+
+func TestClientset_DoguRestarts_ApplyStatus(t *testing.T) {
+	t.Run("should apply status", func(t *testing.T) {
+		// given
+		sut := NewClientset(&v2.DoguRestart{ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart", Namespace: "ecosystem"}}).DoguRestarts("ecosystem")
+
+		// when
+		actual, err := sut.ApplyStatus(testCtx,
+			acv2.DoguRestart("ldap-restart", "ecosystem").WithStatus(acv2.DoguRestartStatus().WithPhase(v2.RestartStatusPhaseStopping)),
+			metav1.ApplyOptions{FieldManager: "operator"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, v2.RestartStatusPhaseStopping, actual.Status.Phase)
+	})
+}
+
+func TestClientset_PrependReactor(t *testing.T) {
+	t.Run("should return injected error and record actions", func(t *testing.T) {
+		// given
+		cs := NewClientset(newTestDogu("ldap", nil))
+		cs.PrependReactor("get", "dogus", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, assert.AnError
+		})
+
+		// when
+		_, err := cs.Dogus("ecosystem").Get(testCtx, "ldap", metav1.GetOptions{})
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		require.Len(t, cs.Actions(), 1)
+		assert.True(t, cs.Actions()[0].Matches("get", "dogus"))
+	})
+}
+
+func TestClientset_DoguRestarts(t *testing.T) {
+	t.Run("should create, list and delete dogu restarts", func(t *testing.T) {
+		// given
+		sut := NewClientset().DoguRestarts("ecosystem")
+		restart := &v2.DoguRestart{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "ldap-restart-", Labels: map[string]string{"dogu.name": "ldap"}},
+			Spec:       v2.DoguRestartSpec{DoguName: "ldap"},
+		}
+
+		// when
+		created, createErr := sut.Create(testCtx, restart, metav1.CreateOptions{})
+		created.Status.Phase = v2.RestartStatusPhaseStopping
+		_, statusErr := sut.UpdateStatus(testCtx, created, metav1.UpdateOptions{})
+		list, listErr := sut.List(testCtx, metav1.ListOptions{LabelSelector: "dogu.name=ldap"})
+		deleteErr := sut.DeleteCollection(testCtx, metav1.DeleteOptions{}, metav1.ListOptions{})
+		remaining, remainingErr := sut.List(testCtx, metav1.ListOptions{})
+
+		// then
+		require.NoError(t, createErr)
+		assert.Regexp(t, "^ldap-restart-", created.Name)
+		require.NoError(t, statusErr)
+		require.NoError(t, listErr)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, v2.RestartStatusPhaseStopping, list.Items[0].Status.Phase)
+		require.NoError(t, deleteErr)
+		require.NoError(t, remainingErr)
+		assert.Empty(t, remaining.Items)
+	})
+}
+
+func doguNames(list *v2.DoguList) []string {
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+	return names
+}
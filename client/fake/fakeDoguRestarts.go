@@ -0,0 +1,111 @@
+//nolint:dupl // generifying the fake clients would lead to a lot of unnecessary complexity
+package fake
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/gentype"
+	"k8s.io/client-go/testing"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+var doguRestartResource = v2.GroupVersion.WithResource("dogurestarts")
+var doguRestartKind = v2.GroupVersion.WithKind("DoguRestart")
+
+// fakeDoguRestarts implements client.DoguRestartInterface
+type fakeDoguRestarts struct {
+	*gentype.FakeClientWithListAndApply[*v2.DoguRestart, *v2.DoguRestartList, *acv2.DoguRestartApplyConfiguration]
+}
+
+func newFakeDoguRestarts(fake *testing.Fake, namespace string) client.DoguRestartInterface {
+	return &fakeDoguRestarts{
+		gentype.NewFakeClientWithListAndApply[*v2.DoguRestart, *v2.DoguRestartList, *acv2.DoguRestartApplyConfiguration](
+			fake,
+			namespace,
+			doguRestartResource,
+			doguRestartKind,
+			func() *v2.DoguRestart { return &v2.DoguRestart{} },
+			func() *v2.DoguRestartList { return &v2.DoguRestartList{} },
+			func(dst, src *v2.DoguRestartList) { dst.ListMeta = src.ListMeta },
+			func(list *v2.DoguRestartList) []*v2.DoguRestart { return gentype.ToPointerSlice(list.Items) },
+			func(list *v2.DoguRestartList, items []*v2.DoguRestart) { list.Items = gentype.FromPointerSlice(items) },
+		),
+	}
+}
+
+// List takes label and field selectors, and returns the list of dogu restarts that match those selectors.
+func (d *fakeDoguRestarts) List(ctx context.Context, opts metav1.ListOptions) (*v2.DoguRestartList, error) {
+	list, err := d.FakeClientWithListAndApply.List(ctx, opts)
+	if err != nil || list == nil {
+		return list, err
+	}
+
+	var items []v2.DoguRestart
+	for _, item := range list.Items {
+		if matchesListOptions(&item, opts) {
+			items = append(items, item)
+		}
+	}
+	list.Items = items
+
+	return list, nil
+}
+
+// UpdateSpecWithRetry updates the spec of the resource, retrying if a conflict error arises.
+func (d *fakeDoguRestarts) UpdateSpecWithRetry(ctx context.Context, doguRestart *v2.DoguRestart, modifySpecFn func(spec v2.DoguRestartSpec) v2.DoguRestartSpec, opts metav1.UpdateOptions) (result *v2.DoguRestart, err error) {
+	firstTry := true
+
+	var currentObj *v2.DoguRestart
+	err = retry.OnConflict(func() error {
+		if firstTry {
+			firstTry = false
+			currentObj = doguRestart.DeepCopy()
+		} else {
+			currentObj, err = d.Get(ctx, doguRestart.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+		}
+
+		currentObj.Spec = modifySpecFn(currentObj.Spec)
+		currentObj, err = d.Update(ctx, currentObj, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return currentObj, nil
+}
+
+// UpdateStatusWithRetry updates the status of the resource, retrying if a conflict error arises.
+func (d *fakeDoguRestarts) UpdateStatusWithRetry(ctx context.Context, doguRestart *v2.DoguRestart, modifyStatusFn func(v2.DoguRestartStatus) v2.DoguRestartStatus, opts metav1.UpdateOptions) (result *v2.DoguRestart, err error) {
+	firstTry := true
+
+	var currentObj *v2.DoguRestart
+	err = retry.OnConflict(func() error {
+		if firstTry {
+			firstTry = false
+			currentObj = doguRestart.DeepCopy()
+		} else {
+			currentObj, err = d.Get(ctx, doguRestart.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+		}
+
+		currentObj.Status = modifyStatusFn(currentObj.Status)
+		currentObj, err = d.UpdateStatus(ctx, currentObj, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return currentObj, nil
+}
@@ -0,0 +1,155 @@
+//nolint:dupl // generifying the fake clients would lead to a lot of unnecessary complexity
+package fake
+
+import (
+	"context"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/gentype"
+	"k8s.io/client-go/testing"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client"
+	acv2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
+	"github.com/cloudogu/retry-lib/retry"
+)
+
+var doguResource = v2.GroupVersion.WithResource("dogus")
+var doguKind = v2.GroupVersion.WithKind("Dogu")
+
+// fakeDogus implements client.DoguInterface
+type fakeDogus struct {
+	*gentype.FakeClientWithListAndApply[*v2.Dogu, *v2.DoguList, *acv2.DoguApplyConfiguration]
+}
+
+func newFakeDogus(fake *testing.Fake, namespace string) client.DoguInterface {
+	return &fakeDogus{
+		gentype.NewFakeClientWithListAndApply[*v2.Dogu, *v2.DoguList, *acv2.DoguApplyConfiguration](
+			fake,
+			namespace,
+			doguResource,
+			doguKind,
+			func() *v2.Dogu { return &v2.Dogu{} },
+			func() *v2.DoguList { return &v2.DoguList{} },
+			func(dst, src *v2.DoguList) { dst.ListMeta = src.ListMeta },
+			func(list *v2.DoguList) []*v2.Dogu { return gentype.ToPointerSlice(list.Items) },
+			func(list *v2.DoguList, items []*v2.Dogu) { list.Items = gentype.FromPointerSlice(items) },
+		),
+	}
+}
+
+// List takes label and field selectors, and returns the list of dogus that match those selectors.
+func (d *fakeDogus) List(ctx context.Context, opts metav1.ListOptions) (*v2.DoguList, error) {
+	list, err := d.FakeClientWithListAndApply.List(ctx, opts)
+	if err != nil || list == nil {
+		return list, err
+	}
+
+	var items []v2.Dogu
+	for _, item := range list.Items {
+		if matchesListOptions(&item, opts) {
+			items = append(items, item)
+		}
+	}
+	list.Items = items
+
+	return list, nil
+}
+
+// UpdateSpecWithRetry updates the spec of the resource, retrying if a conflict error arises.
+func (d *fakeDogus) UpdateSpecWithRetry(ctx context.Context, dogu *v2.Dogu, modifySpecFn func(spec v2.DoguSpec) v2.DoguSpec, opts metav1.UpdateOptions) (result *v2.Dogu, err error) {
+	firstTry := true
+
+	var currentObj *v2.Dogu
+	err = retry.OnConflict(func() error {
+		if firstTry {
+			firstTry = false
+			currentObj = dogu.DeepCopy()
+		} else {
+			currentObj, err = d.Get(ctx, dogu.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+		}
+
+		currentObj.Spec = modifySpecFn(currentObj.Spec)
+		currentObj, err = d.Update(ctx, currentObj, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return currentObj, nil
+}
+
+// UpdateStatusWithRetry updates the status of the resource, retrying if a conflict error arises.
+func (d *fakeDogus) UpdateStatusWithRetry(ctx context.Context, dogu *v2.Dogu, modifyStatusFn func(v2.DoguStatus) v2.DoguStatus, opts metav1.UpdateOptions) (result *v2.Dogu, err error) {
+	firstTry := true
+
+	var currentObj *v2.Dogu
+	err = retry.OnConflict(func() error {
+		if firstTry {
+			firstTry = false
+			currentObj = dogu.DeepCopy()
+		} else {
+			currentObj, err = d.Get(ctx, dogu.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+		}
+
+		currentObj.Status = modifyStatusFn(currentObj.Status)
+		currentObj, err = d.UpdateStatus(ctx, currentObj, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return currentObj, nil
+}
+
+// GetScale returns the scale of the dogu which is computed from its replicas, as the object tracker does not
+// support the scale subresource.
+func (d *fakeDogus) GetScale(ctx context.Context, doguName string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	dogu, err := d.Get(ctx, doguName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return toScale(dogu), nil
+}
+
+// UpdateScale updates the replicas of the dogu to the desired replicas of the given scale.
+func (d *fakeDogus) UpdateScale(ctx context.Context, doguName string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	dogu, err := d.Get(ctx, doguName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := scale.Spec.Replicas
+	dogu.Spec.Replicas = &replicas
+	dogu, err = d.Update(ctx, dogu, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return toScale(dogu), nil
+}
+
+func toScale(dogu *v2.Dogu) *autoscalingv1.Scale {
+	return &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              dogu.Name,
+			Namespace:         dogu.Namespace,
+			UID:               dogu.UID,
+			ResourceVersion:   dogu.ResourceVersion,
+			CreationTimestamp: dogu.CreationTimestamp,
+		},
+		// the API server defaults missing replicas to 1 like GetReplicas does
+		Spec:   autoscalingv1.ScaleSpec{Replicas: dogu.GetReplicas()},
+		Status: autoscalingv1.ScaleStatus{Replicas: dogu.Status.Replicas, Selector: dogu.Status.Selector},
+	}
+}
@@ -0,0 +1,87 @@
+//nolint:dupl // generifying the informers would lead to a lot of unnecessary complexity
+package informers
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client/listers"
+)
+
+// DoguInformer provides access to a shared informer and lister for dogus.
+type DoguInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() listers.DoguLister
+}
+
+type doguInformer struct {
+	factory          SharedInformerFactory
+	tweakListOptions TweakListOptionsFunc
+	namespace        string
+}
+
+// NewDoguInformer constructs a new informer for dogus.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewDoguInformer(client client.EcoSystemV2Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredDoguInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredDoguInformer constructs a new informer for dogus whose list options may be modified by tweakListOptions,
+// e.g. to select dogus by labels.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredDoguInformer(client client.EcoSystemV2Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Dogus(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Dogus(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Dogus(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Dogus(namespace).Watch(ctx, options)
+			},
+		},
+		&v2.Dogu{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *doguInformer) defaultInformer(client client.EcoSystemV2Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredDoguInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+// Informer returns the shared index informer for dogus.
+func (f *doguInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&v2.Dogu{}, f.defaultInformer)
+}
+
+// Lister returns a lister which reads dogus from the informer's cache.
+func (f *doguInformer) Lister() listers.DoguLister {
+	return listers.NewDoguLister(f.Informer().GetIndexer())
+}
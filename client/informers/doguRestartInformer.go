@@ -0,0 +1,87 @@
+//nolint:dupl // generifying the informers would lead to a lot of unnecessary complexity
+package informers
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client/listers"
+)
+
+// DoguRestartInformer provides access to a shared informer and lister for dogu restarts.
+type DoguRestartInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() listers.DoguRestartLister
+}
+
+type doguRestartInformer struct {
+	factory          SharedInformerFactory
+	tweakListOptions TweakListOptionsFunc
+	namespace        string
+}
+
+// NewDoguRestartInformer constructs a new informer for dogu restarts.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewDoguRestartInformer(client client.EcoSystemV2Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredDoguRestartInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredDoguRestartInformer constructs a new informer for dogu restarts whose list options may be modified by tweakListOptions,
+// e.g. to select dogu restarts by labels.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredDoguRestartInformer(client client.EcoSystemV2Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DoguRestarts(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DoguRestarts(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DoguRestarts(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DoguRestarts(namespace).Watch(ctx, options)
+			},
+		},
+		&v2.DoguRestart{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *doguRestartInformer) defaultInformer(client client.EcoSystemV2Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredDoguRestartInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+// Informer returns the shared index informer for dogu restarts.
+func (f *doguRestartInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&v2.DoguRestart{}, f.defaultInformer)
+}
+
+// Lister returns a lister which reads dogu restarts from the informer's cache.
+func (f *doguRestartInformer) Lister() listers.DoguRestartLister {
+	return listers.NewDoguRestartLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,212 @@
+package informers
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	"github.com/cloudogu/k8s-dogu-lib/v2/client"
+)
+
+// stubEcoSystemClient serves fixed lists and fake watchers. Calls of methods not overridden here panic.
+type stubEcoSystemClient struct {
+	mu             sync.Mutex
+	dogus          *stubDoguClient
+	doguRestarts   *stubDoguRestartClient
+	doguNamespaces []string
+}
+
+func newStubEcoSystemClient(dogus []v2.Dogu, restarts []v2.DoguRestart) *stubEcoSystemClient {
+	return &stubEcoSystemClient{
+		dogus:        &stubDoguClient{items: dogus, watcher: watch.NewFake()},
+		doguRestarts: &stubDoguRestartClient{items: restarts, watcher: watch.NewFake()},
+	}
+}
+
+func (s *stubEcoSystemClient) Dogus(namespace string) client.DoguInterface {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.doguNamespaces = append(s.doguNamespaces, namespace)
+	return s.dogus
+}
+
+func (s *stubEcoSystemClient) DoguRestarts(string) client.DoguRestartInterface {
+	return s.doguRestarts
+}
+
+type stubDoguClient struct {
+	client.DoguInterface
+	mu          sync.Mutex
+	items       []v2.Dogu
+	watcher     *watch.FakeWatcher
+	listOptions []metav1.ListOptions
+}
+
+func (s *stubDoguClient) List(_ context.Context, opts metav1.ListOptions) (*v2.DoguList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listOptions = append(s.listOptions, opts)
+	return &v2.DoguList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: s.items}, nil
+}
+
+func (s *stubDoguClient) Watch(context.Context, metav1.ListOptions) (watch.Interface, error) {
+	return s.watcher, nil
+}
+
+type stubDoguRestartClient struct {
+	client.DoguRestartInterface
+	items   []v2.DoguRestart
+	watcher *watch.FakeWatcher
+}
+
+func (s *stubDoguRestartClient) List(context.Context, metav1.ListOptions) (*v2.DoguRestartList, error) {
+	return &v2.DoguRestartList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}, Items: s.items}, nil
+}
+
+func (s *stubDoguRestartClient) Watch(context.Context, metav1.ListOptions) (watch.Interface, error) {
+	return s.watcher, nil
+}
+
+func TestNewSharedInformerFactory(t *testing.T) {
+	t.Run("should sync dogus and dogu restarts into the listers", func(t *testing.T) {
+		// given
+		ldap := v2.Dogu{ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "ecosystem"}}
+		restart := v2.DoguRestart{ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart", Namespace: "ecosystem"}, Spec: v2.DoguRestartSpec{DoguName: "ldap"}}
+		stub := newStubEcoSystemClient([]v2.Dogu{ldap}, []v2.DoguRestart{restart})
+		sut := NewSharedInformerFactory(stub, 0)
+		doguLister := sut.Dogus().Lister()
+		restartLister := sut.DoguRestarts().Lister()
+
+		stopCh := make(chan struct{})
+		defer func() {
+			close(stopCh)
+			sut.Shutdown()
+		}()
+
+		// when
+		sut.Start(stopCh)
+		synced := sut.WaitForCacheSync(stopCh)
+
+		// then
+		assert.Equal(t, map[reflect.Type]bool{
+			reflect.TypeOf(&v2.Dogu{}):        true,
+			reflect.TypeOf(&v2.DoguRestart{}): true,
+		}, synced)
+
+		actualDogu, err := doguLister.Dogus("ecosystem").Get("ldap")
+		require.NoError(t, err)
+		assert.Equal(t, "ldap", actualDogu.Name)
+
+		actualRestarts, err := restartLister.DoguRestarts("ecosystem").List(labels.Everything())
+		require.NoError(t, err)
+		require.Len(t, actualRestarts, 1)
+		assert.Equal(t, "ldap", actualRestarts[0].Spec.DoguName)
+	})
+	t.Run("should notify event handlers about watch events", func(t *testing.T) {
+		// given
+		stub := newStubEcoSystemClient(nil, nil)
+		sut := NewSharedInformerFactory(stub, 0)
+		informer := sut.Dogus().Informer()
+
+		added := make(chan *v2.Dogu, 1)
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				added <- obj.(*v2.Dogu)
+			},
+		})
+		require.NoError(t, err)
+
+		stopCh := make(chan struct{})
+		defer func() {
+			close(stopCh)
+			sut.Shutdown()
+		}()
+		sut.Start(stopCh)
+		sut.WaitForCacheSync(stopCh)
+
+		// when
+		stub.dogus.watcher.Add(&v2.Dogu{ObjectMeta: metav1.ObjectMeta{Name: "cas", Namespace: "ecosystem", ResourceVersion: "2"}})
+
+		// then
+		select {
+		case dogu := <-added:
+			assert.Equal(t, "cas", dogu.Name)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for add event")
+		}
+		actual, err := sut.Dogus().Lister().List(labels.Everything())
+		require.NoError(t, err)
+		assert.Len(t, actual, 1)
+	})
+	t.Run("should return the same informer for multiple calls", func(t *testing.T) {
+		// given
+		sut := NewSharedInformerFactory(newStubEcoSystemClient(nil, nil), 0)
+
+		// when
+		first := sut.Dogus().Informer()
+		second := sut.Dogus().Informer()
+
+		// then
+		assert.Same(t, first, second)
+	})
+}
+
+func TestNewSharedInformerFactoryWithOptions(t *testing.T) {
+	t.Run("should use namespace and tweaked list options", func(t *testing.T) {
+		// given
+		stub := newStubEcoSystemClient(nil, nil)
+		sut := NewSharedInformerFactoryWithOptions(stub, 0,
+			WithNamespace("ecosystem"),
+			WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.LabelSelector = "app=ces"
+			}),
+		)
+		sut.Dogus().Informer()
+
+		stopCh := make(chan struct{})
+		defer func() {
+			close(stopCh)
+			sut.Shutdown()
+		}()
+
+		// when
+		sut.Start(stopCh)
+		sut.WaitForCacheSync(stopCh)
+
+		// then
+		stub.mu.Lock()
+		assert.Contains(t, stub.doguNamespaces, "ecosystem")
+		assert.NotContains(t, stub.doguNamespaces, metav1.NamespaceAll)
+		stub.mu.Unlock()
+		stub.dogus.mu.Lock()
+		require.NotEmpty(t, stub.dogus.listOptions)
+		assert.Equal(t, "app=ces", stub.dogus.listOptions[0].LabelSelector)
+		stub.dogus.mu.Unlock()
+	})
+	t.Run("should use custom resync period", func(t *testing.T) {
+		// given
+		var actualResync time.Duration
+		sut := NewSharedInformerFactoryWithOptions(newStubEcoSystemClient(nil, nil), time.Minute,
+			WithCustomResyncConfig(map[metav1.Object]time.Duration{&v2.Dogu{}: time.Hour}),
+		)
+
+		// when
+		sut.InformerFor(&v2.Dogu{}, func(cli client.EcoSystemV2Interface, resync time.Duration) cache.SharedIndexInformer {
+			actualResync = resync
+			return NewDoguInformer(cli, "", resync, cache.Indexers{})
+		})
+
+		// then
+		assert.Equal(t, time.Hour, actualResync)
+	})
+}
@@ -0,0 +1,49 @@
+package listers
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+// DoguLister helps list dogus.
+// All objects returned here must be treated as read-only.
+type DoguLister interface {
+	// List lists all dogus in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.Dogu, err error)
+	// Dogus returns an object that can list and get dogus in the given namespace.
+	Dogus(namespace string) DoguNamespaceLister
+}
+
+type doguLister struct {
+	listers.ResourceIndexer[*v2.Dogu]
+}
+
+// NewDoguLister returns a new DoguLister backed by the given indexer.
+func NewDoguLister(indexer cache.Indexer) DoguLister {
+	return &doguLister{listers.New[*v2.Dogu](indexer, schema.GroupResource{Group: v2.GroupVersion.Group, Resource: "dogus"})}
+}
+
+// Dogus returns an object that can list and get dogus in the given namespace.
+func (s *doguLister) Dogus(namespace string) DoguNamespaceLister {
+	return doguNamespaceLister{listers.NewNamespaced[*v2.Dogu](s.ResourceIndexer, namespace)}
+}
+
+// DoguNamespaceLister helps list and get dogus of a single namespace.
+// All objects returned here must be treated as read-only.
+type DoguNamespaceLister interface {
+	// List lists all dogus in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.Dogu, err error)
+	// Get retrieves the dogu from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v2.Dogu, error)
+}
+
+type doguNamespaceLister struct {
+	listers.ResourceIndexer[*v2.Dogu]
+}
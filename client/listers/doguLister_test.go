@@ -0,0 +1,91 @@
+package listers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+func newTestDoguIndexer(t *testing.T, dogus ...*v2.Dogu) cache.Indexer {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, dogu := range dogus {
+		require.NoError(t, indexer.Add(dogu))
+	}
+	return indexer
+}
+
+func TestDoguLister_List(t *testing.T) {
+	ldap := &v2.Dogu{ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "ecosystem", Labels: map[string]string{"app": "ces"}}}
+	cas := &v2.Dogu{ObjectMeta: metav1.ObjectMeta{Name: "cas", Namespace: "ecosystem"}}
+	other := &v2.Dogu{ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "other", Labels: map[string]string{"app": "ces"}}}
+
+	t.Run("should list dogus of all namespaces", func(t *testing.T) {
+		// given
+		sut := NewDoguLister(newTestDoguIndexer(t, ldap, cas, other))
+
+		// when
+		actual, err := sut.List(labels.Everything())
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*v2.Dogu{ldap, cas, other}, actual)
+	})
+	t.Run("should list dogus matching the label selector", func(t *testing.T) {
+		// given
+		sut := NewDoguLister(newTestDoguIndexer(t, ldap, cas, other))
+
+		// when
+		actual, err := sut.List(labels.SelectorFromSet(labels.Set{"app": "ces"}))
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*v2.Dogu{ldap, other}, actual)
+	})
+	t.Run("should list dogus of a single namespace", func(t *testing.T) {
+		// given
+		sut := NewDoguLister(newTestDoguIndexer(t, ldap, cas, other))
+
+		// when
+		actual, err := sut.Dogus("ecosystem").List(labels.SelectorFromSet(labels.Set{"app": "ces"}))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*v2.Dogu{ldap}, actual)
+	})
+}
+
+func TestDoguNamespaceLister_Get(t *testing.T) {
+	ldap := &v2.Dogu{ObjectMeta: metav1.ObjectMeta{Name: "ldap", Namespace: "ecosystem"}}
+
+	t.Run("should get dogu", func(t *testing.T) {
+		// given
+		sut := NewDoguLister(newTestDoguIndexer(t, ldap))
+
+		// when
+		actual, err := sut.Dogus("ecosystem").Get("ldap")
+
+		// then
+		require.NoError(t, err)
+		assert.Same(t, ldap, actual)
+	})
+	t.Run("should return not found error for dogu in other namespace", func(t *testing.T) {
+		// given
+		sut := NewDoguLister(newTestDoguIndexer(t, ldap))
+
+		// when
+		_, err := sut.Dogus("other").Get("ldap")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, errors.IsNotFound(err))
+		assert.ErrorContains(t, err, "dogus.k8s.cloudogu.com \"ldap\" not found")
+	})
+}
@@ -0,0 +1,49 @@
+package listers
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+// DoguRestartLister helps list dogu restarts.
+// All objects returned here must be treated as read-only.
+type DoguRestartLister interface {
+	// List lists all dogu restarts in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.DoguRestart, err error)
+	// DoguRestarts returns an object that can list and get dogu restarts in the given namespace.
+	DoguRestarts(namespace string) DoguRestartNamespaceLister
+}
+
+type doguRestartLister struct {
+	listers.ResourceIndexer[*v2.DoguRestart]
+}
+
+// NewDoguRestartLister returns a new DoguRestartLister backed by the given indexer.
+func NewDoguRestartLister(indexer cache.Indexer) DoguRestartLister {
+	return &doguRestartLister{listers.New[*v2.DoguRestart](indexer, schema.GroupResource{Group: v2.GroupVersion.Group, Resource: "dogurestarts"})}
+}
+
+// DoguRestarts returns an object that can list and get dogu restarts in the given namespace.
+func (s *doguRestartLister) DoguRestarts(namespace string) DoguRestartNamespaceLister {
+	return doguRestartNamespaceLister{listers.NewNamespaced[*v2.DoguRestart](s.ResourceIndexer, namespace)}
+}
+
+// DoguRestartNamespaceLister helps list and get dogu restarts of a single namespace.
+// All objects returned here must be treated as read-only.
+type DoguRestartNamespaceLister interface {
+	// List lists all dogu restarts in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.DoguRestart, err error)
+	// Get retrieves the dogu restart from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v2.DoguRestart, error)
+}
+
+type doguRestartNamespaceLister struct {
+	listers.ResourceIndexer[*v2.DoguRestart]
+}
@@ -0,0 +1,60 @@
+package listers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+)
+
+func TestDoguRestartLister(t *testing.T) {
+	ldapRestart := &v2.DoguRestart{ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart", Namespace: "ecosystem", Labels: map[string]string{"dogu.name": "ldap"}}}
+	casRestart := &v2.DoguRestart{ObjectMeta: metav1.ObjectMeta{Name: "cas-restart", Namespace: "ecosystem", Labels: map[string]string{"dogu.name": "cas"}}}
+	otherRestart := &v2.DoguRestart{ObjectMeta: metav1.ObjectMeta{Name: "ldap-restart", Namespace: "other", Labels: map[string]string{"dogu.name": "ldap"}}}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(ldapRestart))
+	require.NoError(t, indexer.Add(casRestart))
+	require.NoError(t, indexer.Add(otherRestart))
+	sut := NewDoguRestartLister(indexer)
+
+	t.Run("should list dogu restarts matching the label selector", func(t *testing.T) {
+		// when
+		actual, err := sut.List(labels.SelectorFromSet(labels.Set{"dogu.name": "ldap"}))
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*v2.DoguRestart{ldapRestart, otherRestart}, actual)
+	})
+	t.Run("should list dogu restarts of a single namespace", func(t *testing.T) {
+		// when
+		actual, err := sut.DoguRestarts("ecosystem").List(labels.Everything())
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*v2.DoguRestart{ldapRestart, casRestart}, actual)
+	})
+	t.Run("should get dogu restart", func(t *testing.T) {
+		// when
+		actual, err := sut.DoguRestarts("other").Get("ldap-restart")
+
+		// then
+		require.NoError(t, err)
+		assert.Same(t, otherRestart, actual)
+	})
+	t.Run("should return not found error", func(t *testing.T) {
+		// when
+		_, err := sut.DoguRestarts("other").Get("cas-restart")
+
+		// then
+		require.Error(t, err)
+		assert.True(t, errors.IsNotFound(err))
+		assert.ErrorContains(t, err, "dogurestarts.k8s.cloudogu.com \"cas-restart\" not found")
+	})
+}
@@ -5,12 +5,15 @@ package client
 import (
 	context "context"
 
+	apiv2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+
 	mock "github.com/stretchr/testify/mock"
+
 	types "k8s.io/apimachinery/pkg/types"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	v2 "github.com/cloudogu/k8s-dogu-lib/v2/api/v2"
+	v2 "github.com/cloudogu/k8s-dogu-lib/v2/client/applyconfiguration/api/v2"
 
 	watch "k8s.io/apimachinery/pkg/watch"
 )
@@ -28,28 +31,148 @@ func (_m *MockDoguRestartInterface) EXPECT() *MockDoguRestartInterface_Expecter
 	return &MockDoguRestartInterface_Expecter{mock: &_m.Mock}
 }
 
+// Apply provides a mock function with given fields: ctx, doguRestart, opts
+func (_m *MockDoguRestartInterface) Apply(ctx context.Context, doguRestart *v2.DoguRestartApplyConfiguration, opts v1.ApplyOptions) (*apiv2.DoguRestart, error) {
+	ret := _m.Called(ctx, doguRestart, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 *apiv2.DoguRestart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) (*apiv2.DoguRestart, error)); ok {
+		return rf(ctx, doguRestart, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) *apiv2.DoguRestart); ok {
+		r0 = rf(ctx, doguRestart, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) error); ok {
+		r1 = rf(ctx, doguRestart, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDoguRestartInterface_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type MockDoguRestartInterface_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - doguRestart *v2.DoguRestartApplyConfiguration
+//   - opts v1.ApplyOptions
+func (_e *MockDoguRestartInterface_Expecter) Apply(ctx interface{}, doguRestart interface{}, opts interface{}) *MockDoguRestartInterface_Apply_Call {
+	return &MockDoguRestartInterface_Apply_Call{Call: _e.mock.On("Apply", ctx, doguRestart, opts)}
+}
+
+func (_c *MockDoguRestartInterface_Apply_Call) Run(run func(ctx context.Context, doguRestart *v2.DoguRestartApplyConfiguration, opts v1.ApplyOptions)) *MockDoguRestartInterface_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v2.DoguRestartApplyConfiguration), args[2].(v1.ApplyOptions))
+	})
+	return _c
+}
+
+func (_c *MockDoguRestartInterface_Apply_Call) Return(result *apiv2.DoguRestart, err error) *MockDoguRestartInterface_Apply_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *MockDoguRestartInterface_Apply_Call) RunAndReturn(run func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyStatus provides a mock function with given fields: ctx, doguRestart, opts
+func (_m *MockDoguRestartInterface) ApplyStatus(ctx context.Context, doguRestart *v2.DoguRestartApplyConfiguration, opts v1.ApplyOptions) (*apiv2.DoguRestart, error) {
+	ret := _m.Called(ctx, doguRestart, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyStatus")
+	}
+
+	var r0 *apiv2.DoguRestart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) (*apiv2.DoguRestart, error)); ok {
+		return rf(ctx, doguRestart, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) *apiv2.DoguRestart); ok {
+		r0 = rf(ctx, doguRestart, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) error); ok {
+		r1 = rf(ctx, doguRestart, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDoguRestartInterface_ApplyStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyStatus'
+type MockDoguRestartInterface_ApplyStatus_Call struct {
+	*mock.Call
+}
+
+// ApplyStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - doguRestart *v2.DoguRestartApplyConfiguration
+//   - opts v1.ApplyOptions
+func (_e *MockDoguRestartInterface_Expecter) ApplyStatus(ctx interface{}, doguRestart interface{}, opts interface{}) *MockDoguRestartInterface_ApplyStatus_Call {
+	return &MockDoguRestartInterface_ApplyStatus_Call{Call: _e.mock.On("ApplyStatus", ctx, doguRestart, opts)}
+}
+
+func (_c *MockDoguRestartInterface_ApplyStatus_Call) Run(run func(ctx context.Context, doguRestart *v2.DoguRestartApplyConfiguration, opts v1.ApplyOptions)) *MockDoguRestartInterface_ApplyStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v2.DoguRestartApplyConfiguration), args[2].(v1.ApplyOptions))
+	})
+	return _c
+}
+
+func (_c *MockDoguRestartInterface_ApplyStatus_Call) Return(result *apiv2.DoguRestart, err error) *MockDoguRestartInterface_ApplyStatus_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *MockDoguRestartInterface_ApplyStatus_Call) RunAndReturn(run func(context.Context, *v2.DoguRestartApplyConfiguration, v1.ApplyOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_ApplyStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, dogu, opts
-func (_m *MockDoguRestartInterface) Create(ctx context.Context, dogu *v2.DoguRestart, opts v1.CreateOptions) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) Create(ctx context.Context, dogu *apiv2.DoguRestart, opts v1.CreateOptions) (*apiv2.DoguRestart, error) {
 	ret := _m.Called(ctx, dogu, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, v1.CreateOptions) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, v1.CreateOptions) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, dogu, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, v1.CreateOptions) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, v1.CreateOptions) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, dogu, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestart, v1.CreateOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *apiv2.DoguRestart, v1.CreateOptions) error); ok {
 		r1 = rf(ctx, dogu, opts)
 	} else {
 		r1 = ret.Error(1)
@@ -65,25 +188,25 @@ type MockDoguRestartInterface_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dogu *v2.DoguRestart
+//   - dogu *apiv2.DoguRestart
 //   - opts v1.CreateOptions
 func (_e *MockDoguRestartInterface_Expecter) Create(ctx interface{}, dogu interface{}, opts interface{}) *MockDoguRestartInterface_Create_Call {
 	return &MockDoguRestartInterface_Create_Call{Call: _e.mock.On("Create", ctx, dogu, opts)}
 }
 
-func (_c *MockDoguRestartInterface_Create_Call) Run(run func(ctx context.Context, dogu *v2.DoguRestart, opts v1.CreateOptions)) *MockDoguRestartInterface_Create_Call {
+func (_c *MockDoguRestartInterface_Create_Call) Run(run func(ctx context.Context, dogu *apiv2.DoguRestart, opts v1.CreateOptions)) *MockDoguRestartInterface_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v2.DoguRestart), args[2].(v1.CreateOptions))
+		run(args[0].(context.Context), args[1].(*apiv2.DoguRestart), args[2].(v1.CreateOptions))
 	})
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Create_Call) Return(_a0 *v2.DoguRestart, _a1 error) *MockDoguRestartInterface_Create_Call {
+func (_c *MockDoguRestartInterface_Create_Call) Return(_a0 *apiv2.DoguRestart, _a1 error) *MockDoguRestartInterface_Create_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Create_Call) RunAndReturn(run func(context.Context, *v2.DoguRestart, v1.CreateOptions) (*v2.DoguRestart, error)) *MockDoguRestartInterface_Create_Call {
+func (_c *MockDoguRestartInterface_Create_Call) RunAndReturn(run func(context.Context, *apiv2.DoguRestart, v1.CreateOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -185,23 +308,23 @@ func (_c *MockDoguRestartInterface_DeleteCollection_Call) RunAndReturn(run func(
 }
 
 // Get provides a mock function with given fields: ctx, name, opts
-func (_m *MockDoguRestartInterface) Get(ctx context.Context, name string, opts v1.GetOptions) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv2.DoguRestart, error) {
 	ret := _m.Called(ctx, name, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, name, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, name, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
@@ -234,34 +357,34 @@ func (_c *MockDoguRestartInterface_Get_Call) Run(run func(ctx context.Context, n
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Get_Call) Return(_a0 *v2.DoguRestart, _a1 error) *MockDoguRestartInterface_Get_Call {
+func (_c *MockDoguRestartInterface_Get_Call) Return(_a0 *apiv2.DoguRestart, _a1 error) *MockDoguRestartInterface_Get_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Get_Call) RunAndReturn(run func(context.Context, string, v1.GetOptions) (*v2.DoguRestart, error)) *MockDoguRestartInterface_Get_Call {
+func (_c *MockDoguRestartInterface_Get_Call) RunAndReturn(run func(context.Context, string, v1.GetOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // List provides a mock function with given fields: ctx, opts
-func (_m *MockDoguRestartInterface) List(ctx context.Context, opts v1.ListOptions) (*v2.DoguRestartList, error) {
+func (_m *MockDoguRestartInterface) List(ctx context.Context, opts v1.ListOptions) (*apiv2.DoguRestartList, error) {
 	ret := _m.Called(ctx, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 *v2.DoguRestartList
+	var r0 *apiv2.DoguRestartList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) (*v2.DoguRestartList, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) (*apiv2.DoguRestartList, error)); ok {
 		return rf(ctx, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) *v2.DoguRestartList); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) *apiv2.DoguRestartList); ok {
 		r0 = rf(ctx, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestartList)
+			r0 = ret.Get(0).(*apiv2.DoguRestartList)
 		}
 	}
 
@@ -293,18 +416,18 @@ func (_c *MockDoguRestartInterface_List_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_List_Call) Return(_a0 *v2.DoguRestartList, _a1 error) *MockDoguRestartInterface_List_Call {
+func (_c *MockDoguRestartInterface_List_Call) Return(_a0 *apiv2.DoguRestartList, _a1 error) *MockDoguRestartInterface_List_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_List_Call) RunAndReturn(run func(context.Context, v1.ListOptions) (*v2.DoguRestartList, error)) *MockDoguRestartInterface_List_Call {
+func (_c *MockDoguRestartInterface_List_Call) RunAndReturn(run func(context.Context, v1.ListOptions) (*apiv2.DoguRestartList, error)) *MockDoguRestartInterface_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Patch provides a mock function with given fields: ctx, name, pt, data, opts, subresources
-func (_m *MockDoguRestartInterface) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (*apiv2.DoguRestart, error) {
 	_va := make([]interface{}, len(subresources))
 	for _i := range subresources {
 		_va[_i] = subresources[_i]
@@ -318,16 +441,16 @@ func (_m *MockDoguRestartInterface) Patch(ctx context.Context, name string, pt t
 		panic("no return value specified for Patch")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, name, pt, data, opts, subresources...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, name, pt, data, opts, subresources...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
@@ -370,38 +493,38 @@ func (_c *MockDoguRestartInterface_Patch_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Patch_Call) Return(result *v2.DoguRestart, err error) *MockDoguRestartInterface_Patch_Call {
+func (_c *MockDoguRestartInterface_Patch_Call) Return(result *apiv2.DoguRestart, err error) *MockDoguRestartInterface_Patch_Call {
 	_c.Call.Return(result, err)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Patch_Call) RunAndReturn(run func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) (*v2.DoguRestart, error)) *MockDoguRestartInterface_Patch_Call {
+func (_c *MockDoguRestartInterface_Patch_Call) RunAndReturn(run func(context.Context, string, types.PatchType, []byte, v1.PatchOptions, ...string) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_Patch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Update provides a mock function with given fields: ctx, dogu, opts
-func (_m *MockDoguRestartInterface) Update(ctx context.Context, dogu *v2.DoguRestart, opts v1.UpdateOptions) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) Update(ctx context.Context, dogu *apiv2.DoguRestart, opts v1.UpdateOptions) (*apiv2.DoguRestart, error) {
 	ret := _m.Called(ctx, dogu, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, v1.UpdateOptions) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, dogu, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, v1.UpdateOptions) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, dogu, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestart, v1.UpdateOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) error); ok {
 		r1 = rf(ctx, dogu, opts)
 	} else {
 		r1 = ret.Error(1)
@@ -417,51 +540,51 @@ type MockDoguRestartInterface_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dogu *v2.DoguRestart
+//   - dogu *apiv2.DoguRestart
 //   - opts v1.UpdateOptions
 func (_e *MockDoguRestartInterface_Expecter) Update(ctx interface{}, dogu interface{}, opts interface{}) *MockDoguRestartInterface_Update_Call {
 	return &MockDoguRestartInterface_Update_Call{Call: _e.mock.On("Update", ctx, dogu, opts)}
 }
 
-func (_c *MockDoguRestartInterface_Update_Call) Run(run func(ctx context.Context, dogu *v2.DoguRestart, opts v1.UpdateOptions)) *MockDoguRestartInterface_Update_Call {
+func (_c *MockDoguRestartInterface_Update_Call) Run(run func(ctx context.Context, dogu *apiv2.DoguRestart, opts v1.UpdateOptions)) *MockDoguRestartInterface_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v2.DoguRestart), args[2].(v1.UpdateOptions))
+		run(args[0].(context.Context), args[1].(*apiv2.DoguRestart), args[2].(v1.UpdateOptions))
 	})
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Update_Call) Return(_a0 *v2.DoguRestart, _a1 error) *MockDoguRestartInterface_Update_Call {
+func (_c *MockDoguRestartInterface_Update_Call) Return(_a0 *apiv2.DoguRestart, _a1 error) *MockDoguRestartInterface_Update_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_Update_Call) RunAndReturn(run func(context.Context, *v2.DoguRestart, v1.UpdateOptions) (*v2.DoguRestart, error)) *MockDoguRestartInterface_Update_Call {
+func (_c *MockDoguRestartInterface_Update_Call) RunAndReturn(run func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateSpecWithRetry provides a mock function with given fields: ctx, doguRestart, modifySpecFn, opts
-func (_m *MockDoguRestartInterface) UpdateSpecWithRetry(ctx context.Context, doguRestart *v2.DoguRestart, modifySpecFn func(v2.DoguRestartSpec) v2.DoguRestartSpec, opts v1.UpdateOptions) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) UpdateSpecWithRetry(ctx context.Context, doguRestart *apiv2.DoguRestart, modifySpecFn func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec, opts v1.UpdateOptions) (*apiv2.DoguRestart, error) {
 	ret := _m.Called(ctx, doguRestart, modifySpecFn, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSpecWithRetry")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, func(v2.DoguRestartSpec) v2.DoguRestartSpec, v1.UpdateOptions) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec, v1.UpdateOptions) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, doguRestart, modifySpecFn, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, func(v2.DoguRestartSpec) v2.DoguRestartSpec, v1.UpdateOptions) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec, v1.UpdateOptions) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, doguRestart, modifySpecFn, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestart, func(v2.DoguRestartSpec) v2.DoguRestartSpec, v1.UpdateOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec, v1.UpdateOptions) error); ok {
 		r1 = rf(ctx, doguRestart, modifySpecFn, opts)
 	} else {
 		r1 = ret.Error(1)
@@ -477,52 +600,52 @@ type MockDoguRestartInterface_UpdateSpecWithRetry_Call struct {
 
 // UpdateSpecWithRetry is a helper method to define mock.On call
 //   - ctx context.Context
-//   - doguRestart *v2.DoguRestart
-//   - modifySpecFn func(v2.DoguRestartSpec) v2.DoguRestartSpec
+//   - doguRestart *apiv2.DoguRestart
+//   - modifySpecFn func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec
 //   - opts v1.UpdateOptions
 func (_e *MockDoguRestartInterface_Expecter) UpdateSpecWithRetry(ctx interface{}, doguRestart interface{}, modifySpecFn interface{}, opts interface{}) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
 	return &MockDoguRestartInterface_UpdateSpecWithRetry_Call{Call: _e.mock.On("UpdateSpecWithRetry", ctx, doguRestart, modifySpecFn, opts)}
 }
 
-func (_c *MockDoguRestartInterface_UpdateSpecWithRetry_Call) Run(run func(ctx context.Context, doguRestart *v2.DoguRestart, modifySpecFn func(v2.DoguRestartSpec) v2.DoguRestartSpec, opts v1.UpdateOptions)) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
+func (_c *MockDoguRestartInterface_UpdateSpecWithRetry_Call) Run(run func(ctx context.Context, doguRestart *apiv2.DoguRestart, modifySpecFn func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec, opts v1.UpdateOptions)) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v2.DoguRestart), args[2].(func(v2.DoguRestartSpec) v2.DoguRestartSpec), args[3].(v1.UpdateOptions))
+		run(args[0].(context.Context), args[1].(*apiv2.DoguRestart), args[2].(func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec), args[3].(v1.UpdateOptions))
 	})
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_UpdateSpecWithRetry_Call) Return(result *v2.DoguRestart, err error) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
+func (_c *MockDoguRestartInterface_UpdateSpecWithRetry_Call) Return(result *apiv2.DoguRestart, err error) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
 	_c.Call.Return(result, err)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_UpdateSpecWithRetry_Call) RunAndReturn(run func(context.Context, *v2.DoguRestart, func(v2.DoguRestartSpec) v2.DoguRestartSpec, v1.UpdateOptions) (*v2.DoguRestart, error)) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
+func (_c *MockDoguRestartInterface_UpdateSpecWithRetry_Call) RunAndReturn(run func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartSpec) apiv2.DoguRestartSpec, v1.UpdateOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_UpdateSpecWithRetry_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateStatus provides a mock function with given fields: ctx, dogu, opts
-func (_m *MockDoguRestartInterface) UpdateStatus(ctx context.Context, dogu *v2.DoguRestart, opts v1.UpdateOptions) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) UpdateStatus(ctx context.Context, dogu *apiv2.DoguRestart, opts v1.UpdateOptions) (*apiv2.DoguRestart, error) {
 	ret := _m.Called(ctx, dogu, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStatus")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, v1.UpdateOptions) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, dogu, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, v1.UpdateOptions) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, dogu, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestart, v1.UpdateOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) error); ok {
 		r1 = rf(ctx, dogu, opts)
 	} else {
 		r1 = ret.Error(1)
@@ -538,51 +661,51 @@ type MockDoguRestartInterface_UpdateStatus_Call struct {
 
 // UpdateStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dogu *v2.DoguRestart
+//   - dogu *apiv2.DoguRestart
 //   - opts v1.UpdateOptions
 func (_e *MockDoguRestartInterface_Expecter) UpdateStatus(ctx interface{}, dogu interface{}, opts interface{}) *MockDoguRestartInterface_UpdateStatus_Call {
 	return &MockDoguRestartInterface_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, dogu, opts)}
 }
 
-func (_c *MockDoguRestartInterface_UpdateStatus_Call) Run(run func(ctx context.Context, dogu *v2.DoguRestart, opts v1.UpdateOptions)) *MockDoguRestartInterface_UpdateStatus_Call {
+func (_c *MockDoguRestartInterface_UpdateStatus_Call) Run(run func(ctx context.Context, dogu *apiv2.DoguRestart, opts v1.UpdateOptions)) *MockDoguRestartInterface_UpdateStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v2.DoguRestart), args[2].(v1.UpdateOptions))
+		run(args[0].(context.Context), args[1].(*apiv2.DoguRestart), args[2].(v1.UpdateOptions))
 	})
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_UpdateStatus_Call) Return(_a0 *v2.DoguRestart, _a1 error) *MockDoguRestartInterface_UpdateStatus_Call {
+func (_c *MockDoguRestartInterface_UpdateStatus_Call) Return(_a0 *apiv2.DoguRestart, _a1 error) *MockDoguRestartInterface_UpdateStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_UpdateStatus_Call) RunAndReturn(run func(context.Context, *v2.DoguRestart, v1.UpdateOptions) (*v2.DoguRestart, error)) *MockDoguRestartInterface_UpdateStatus_Call {
+func (_c *MockDoguRestartInterface_UpdateStatus_Call) RunAndReturn(run func(context.Context, *apiv2.DoguRestart, v1.UpdateOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateStatusWithRetry provides a mock function with given fields: ctx, doguRestart, modifyStatusFn, opts
-func (_m *MockDoguRestartInterface) UpdateStatusWithRetry(ctx context.Context, doguRestart *v2.DoguRestart, modifyStatusFn func(v2.DoguRestartStatus) v2.DoguRestartStatus, opts v1.UpdateOptions) (*v2.DoguRestart, error) {
+func (_m *MockDoguRestartInterface) UpdateStatusWithRetry(ctx context.Context, doguRestart *apiv2.DoguRestart, modifyStatusFn func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus, opts v1.UpdateOptions) (*apiv2.DoguRestart, error) {
 	ret := _m.Called(ctx, doguRestart, modifyStatusFn, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStatusWithRetry")
 	}
 
-	var r0 *v2.DoguRestart
+	var r0 *apiv2.DoguRestart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, func(v2.DoguRestartStatus) v2.DoguRestartStatus, v1.UpdateOptions) (*v2.DoguRestart, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus, v1.UpdateOptions) (*apiv2.DoguRestart, error)); ok {
 		return rf(ctx, doguRestart, modifyStatusFn, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *v2.DoguRestart, func(v2.DoguRestartStatus) v2.DoguRestartStatus, v1.UpdateOptions) *v2.DoguRestart); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus, v1.UpdateOptions) *apiv2.DoguRestart); ok {
 		r0 = rf(ctx, doguRestart, modifyStatusFn, opts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v2.DoguRestart)
+			r0 = ret.Get(0).(*apiv2.DoguRestart)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *v2.DoguRestart, func(v2.DoguRestartStatus) v2.DoguRestartStatus, v1.UpdateOptions) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus, v1.UpdateOptions) error); ok {
 		r1 = rf(ctx, doguRestart, modifyStatusFn, opts)
 	} else {
 		r1 = ret.Error(1)
@@ -598,26 +721,26 @@ type MockDoguRestartInterface_UpdateStatusWithRetry_Call struct {
 
 // UpdateStatusWithRetry is a helper method to define mock.On call
 //   - ctx context.Context
-//   - doguRestart *v2.DoguRestart
-//   - modifyStatusFn func(v2.DoguRestartStatus) v2.DoguRestartStatus
+//   - doguRestart *apiv2.DoguRestart
+//   - modifyStatusFn func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus
 //   - opts v1.UpdateOptions
 func (_e *MockDoguRestartInterface_Expecter) UpdateStatusWithRetry(ctx interface{}, doguRestart interface{}, modifyStatusFn interface{}, opts interface{}) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
 	return &MockDoguRestartInterface_UpdateStatusWithRetry_Call{Call: _e.mock.On("UpdateStatusWithRetry", ctx, doguRestart, modifyStatusFn, opts)}
 }
 
-func (_c *MockDoguRestartInterface_UpdateStatusWithRetry_Call) Run(run func(ctx context.Context, doguRestart *v2.DoguRestart, modifyStatusFn func(v2.DoguRestartStatus) v2.DoguRestartStatus, opts v1.UpdateOptions)) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
+func (_c *MockDoguRestartInterface_UpdateStatusWithRetry_Call) Run(run func(ctx context.Context, doguRestart *apiv2.DoguRestart, modifyStatusFn func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus, opts v1.UpdateOptions)) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*v2.DoguRestart), args[2].(func(v2.DoguRestartStatus) v2.DoguRestartStatus), args[3].(v1.UpdateOptions))
+		run(args[0].(context.Context), args[1].(*apiv2.DoguRestart), args[2].(func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus), args[3].(v1.UpdateOptions))
 	})
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_UpdateStatusWithRetry_Call) Return(result *v2.DoguRestart, err error) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
+func (_c *MockDoguRestartInterface_UpdateStatusWithRetry_Call) Return(result *apiv2.DoguRestart, err error) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
 	_c.Call.Return(result, err)
 	return _c
 }
 
-func (_c *MockDoguRestartInterface_UpdateStatusWithRetry_Call) RunAndReturn(run func(context.Context, *v2.DoguRestart, func(v2.DoguRestartStatus) v2.DoguRestartStatus, v1.UpdateOptions) (*v2.DoguRestart, error)) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
+func (_c *MockDoguRestartInterface_UpdateStatusWithRetry_Call) RunAndReturn(run func(context.Context, *apiv2.DoguRestart, func(apiv2.DoguRestartStatus) apiv2.DoguRestartStatus, v1.UpdateOptions) (*apiv2.DoguRestart, error)) *MockDoguRestartInterface_UpdateStatusWithRetry_Call {
 	_c.Call.Return(run)
 	return _c
 }